@@ -0,0 +1,153 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/leonidboykov/go-mutesting/internal/importing"
+)
+
+var listMutantsCommand = &cli.Command{
+	Name:      "list-mutants",
+	Usage:     "List all mutants without executing tests",
+	ArgsUsage: "[packages]",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "json",
+			Usage: "print mutants in JSON format",
+		},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		s, err := newSuite(suiteOptions(c))
+		if err != nil {
+			return fmt.Errorf("prepare mutation framework: %w", err)
+		}
+		list, err := s.listMutants(ctx)
+		if err != nil {
+			return fmt.Errorf("list mutants: %w", err)
+		}
+		if c.Bool("json") {
+			return json.NewEncoder(os.Stdout).Encode(list)
+		}
+		return list.print(os.Stdout)
+	},
+}
+
+// mutantList is a result of a dry run.
+type mutantList struct {
+	Mutants []mutantItem `json:"mutants"`
+	// Mutators and Packages contain the number of mutants per mutator and per package.
+	Mutators   map[string]int `json:"mutators"`
+	Packages   map[string]int `json:"packages"`
+	Duplicated int            `json:"duplicated"`
+	Ignored    int            `json:"ignored"`
+	Total      int            `json:"total"`
+}
+
+type mutantItem struct {
+	ID          string `json:"id"`
+	Mutator     string `json:"mutator"`
+	Package     string `json:"package"`
+	File        string `json:"file"`
+	Line        int    `json:"line"`
+	Column      int    `json:"column"`
+	Description string `json:"description"`
+	Checksum    string `json:"checksum"`
+}
+
+// listMutants generates all mutants without writing or executing them. Duplicated and ignored mutants are counted,
+// but not listed, exactly as they would be skipped by the execution.
+func (s *suite) listMutants(ctx context.Context) (*mutantList, error) {
+	list := &mutantList{
+		Mutants:  []mutantItem{},
+		Mutators: make(map[string]int),
+		Packages: make(map[string]int),
+	}
+
+	files, err := importing.FilesOfArgs(ctx, s.opts.args, s.opts.importingOpts)
+	if err != nil {
+		return nil, fmt.Errorf("load packages: %w", err)
+	}
+
+	for _, file := range files {
+		mutants, err := s.mutants(ctx, file)
+		if err != nil {
+			return nil, err
+		}
+		for m := range mutants {
+			if s.isIgnored(m) {
+				list.Ignored++
+				continue
+			}
+			if s.isDuplicate(m) {
+				list.Duplicated++
+				continue
+			}
+
+			list.Mutants = append(list.Mutants, mutantItem{
+				ID:          m.ID,
+				Mutator:     m.Mutator,
+				Package:     m.Package.PkgPath,
+				File:        displayPath(m.File),
+				Line:        m.Pos.Line,
+				Column:      m.Pos.Column,
+				Description: m.Description,
+				Checksum:    m.Checksum,
+			})
+			list.Mutators[m.Mutator]++
+			list.Packages[m.Package.PkgPath]++
+			list.Total++
+		}
+	}
+
+	return list, nil
+}
+
+func (l *mutantList) print(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	for _, m := range l.Mutants {
+		fmt.Fprintf(tw, "%s\t%s:%d:%d\t%s\t%s\n", m.ID, m.File, m.Line, m.Column, m.Mutator, m.Description)
+	}
+
+	fmt.Fprintln(tw, "\nMutants per mutator:")
+	printCounts(tw, l.Mutators)
+	fmt.Fprintln(tw, "\nMutants per package:")
+	printCounts(tw, l.Packages)
+
+	fmt.Fprintf(tw, "\nTotal: %d (%d duplicated, %d ignored)\n", l.Total, l.Duplicated, l.Ignored)
+	return tw.Flush()
+}
+
+// printCounts prints counts in descending order.
+func printCounts(w io.Writer, counts map[string]int) {
+	keys := slices.SortedFunc(maps.Keys(counts), func(a, b string) int {
+		return cmp.Or(cmp.Compare(counts[b], counts[a]), strings.Compare(a, b))
+	})
+	for _, k := range keys {
+		fmt.Fprintf(w, "  %s\t%d\n", k, counts[k])
+	}
+}
+
+// displayPath returns the path relative to the working directory if the file is inside of it.
+func displayPath(file string) string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return file
+	}
+	rel, err := filepath.Rel(wd, file)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return file
+	}
+	return rel
+}
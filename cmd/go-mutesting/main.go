@@ -1,24 +1,14 @@
 package main
 
 import (
-	"bytes"
 	"context"
-	"crypto/md5"
-	"encoding/hex"
 	"errors"
 	"fmt"
-	"go/ast"
-	"go/format"
-	"go/printer"
-	"go/token"
-	"go/types"
-	"io"
 	"log"
 	"log/slog"
 	"os"
 	"os/signal"
 	"path/filepath"
-	"regexp"
 	"slices"
 	"strings"
 	"time"
@@ -29,19 +19,31 @@ import (
 	yamlsrc "github.com/urfave/cli-altsrc/v3/yaml"
 	"github.com/urfave/cli/v3"
 	"golang.org/x/tools/go/packages"
+	"gopkg.in/yaml.v3"
 
-	"github.com/leonidboykov/go-mutesting"
-	"github.com/leonidboykov/go-mutesting/internal/astutil"
 	"github.com/leonidboykov/go-mutesting/internal/execute"
+	"github.com/leonidboykov/go-mutesting/internal/ignore"
 	"github.com/leonidboykov/go-mutesting/internal/importing"
 	"github.com/leonidboykov/go-mutesting/internal/report"
 	"github.com/leonidboykov/go-mutesting/mutator"
 	_ "github.com/leonidboykov/go-mutesting/mutator/arithmetic"
 	_ "github.com/leonidboykov/go-mutesting/mutator/branch"
+	_ "github.com/leonidboykov/go-mutesting/mutator/builtin"
+	_ "github.com/leonidboykov/go-mutesting/mutator/call"
+	_ "github.com/leonidboykov/go-mutesting/mutator/commaok"
+	_ "github.com/leonidboykov/go-mutesting/mutator/concurrency"
+	_ "github.com/leonidboykov/go-mutesting/mutator/conditional"
+	_ "github.com/leonidboykov/go-mutesting/mutator/context"
+	_ "github.com/leonidboykov/go-mutesting/mutator/errorhandling"
 	_ "github.com/leonidboykov/go-mutesting/mutator/expression"
+	_ "github.com/leonidboykov/go-mutesting/mutator/literal"
 	_ "github.com/leonidboykov/go-mutesting/mutator/loop"
 	_ "github.com/leonidboykov/go-mutesting/mutator/numbers"
+	_ "github.com/leonidboykov/go-mutesting/mutator/returns"
+	"github.com/leonidboykov/go-mutesting/mutator/rule"
+	_ "github.com/leonidboykov/go-mutesting/mutator/slice"
 	_ "github.com/leonidboykov/go-mutesting/mutator/statement"
+	"github.com/leonidboykov/go-mutesting/mutator/stdlib"
 )
 
 const md5Len = 32
@@ -93,10 +95,25 @@ func main() {
 				Name:  "disable",
 				Usage: "disable mutator by their name or using * as a suffix pattern (in order to check remaining enabled mutators use --verbose option)",
 			},
+			&cli.StringSliceFlag{
+				Name:  "stdlib-swap",
+				Usage: "additional swaps of the stdlib/swap mutator in the form `FUNC=NAME`, e.g. strings.ToUpper=ToTitle",
+				Sources: cli.NewValueSourceChain(
+					yamlsrc.YAML("stdlib_swap", altsrc.NewStringPtrSourcer(&configFile)),
+				),
+			},
 			&cli.StringSliceFlag{
 				Name:  "blacklist",
 				Usage: "list of MD5 checksums of mutations which should be ignored. Each checksum must end with a new line character",
 			},
+			&cli.StringFlag{
+				Name:  "ignore-file",
+				Usage: "`FILE` with triaged mutants, mutants marked as equivalent are not executed",
+				Value: ".go-mutesting-ignore.yml",
+				Sources: cli.NewValueSourceChain(
+					yamlsrc.YAML("ignore_file", altsrc.NewStringPtrSourcer(&configFile)),
+				),
+			},
 			&cli.StringFlag{
 				Name:  "match",
 				Usage: "only functions are mutated that confirm to the arguments regex",
@@ -132,6 +149,13 @@ func main() {
 				Usage: "sets a timeout for the command execution in seconds",
 				Value: 10,
 			},
+			&cli.BoolFlag{
+				Name:  "timeout-killed",
+				Usage: "count mutants exceeding the exec timeout as killed and enable mutators which can produce non-terminating loops",
+				Sources: cli.NewValueSourceChain(
+					yamlsrc.YAML("timeout_killed", altsrc.NewStringPtrSourcer(&configFile)),
+				),
+			},
 			&cli.BoolFlag{
 				Name:  "silent-mode",
 				Usage: "suppress output",
@@ -139,13 +163,27 @@ func main() {
 					yamlsrc.YAML("silent_mode", altsrc.NewStringPtrSourcer(&configFile)),
 				),
 			},
+			&cli.StringSliceFlag{
+				Name:  "exclude",
+				Usage: "exclude files matching glob `PATTERN` from analyze, e.g. mocks or *_string.go",
+				Sources: cli.NewValueSourceChain(
+					yamlsrc.YAML("exclude", altsrc.NewStringPtrSourcer(&configFile)),
+				),
+			},
 			&cli.StringSliceFlag{
 				Name:  "exclude-dirs",
-				Usage: "exclude dirs from analyze",
+				Usage: "exclude dirs from analyze (deprecated: use --exclude)",
 				Sources: cli.NewValueSourceChain(
 					yamlsrc.YAML("exclude_dirs", altsrc.NewStringPtrSourcer(&configFile)),
 				),
 			},
+			&cli.BoolFlag{
+				Name:  "include-generated",
+				Usage: "do not skip generated files with the \"Code generated ... DO NOT EDIT.\" header",
+				Sources: cli.NewValueSourceChain(
+					yamlsrc.YAML("include_generated", altsrc.NewStringPtrSourcer(&configFile)),
+				),
+			},
 			&cli.BoolFlag{
 				Name:  "json-output",
 				Usage: "output logs in json format",
@@ -171,34 +209,22 @@ func main() {
 			default:
 				logLevel.Set(slog.LevelWarn)
 			}
+			if err := loadRules(configFile); err != nil {
+				return ctx, fmt.Errorf("load rules: %w", err)
+			}
 			return ctx, nil
 		},
 		Commands: []*cli.Command{
 			listFilesCommand,
 			listMutatorsCommand,
+			listMutantsCommand,
+			showCommand,
+			applyCommand,
+			triageCommand,
 			printASTCommand,
 		},
 		Action: func(ctx context.Context, c *cli.Command) error {
-			suite, err := newSuite(options{
-				args:                 c.Args().Slice(),
-				disabledMutators:     c.StringSlice("disable"),
-				blacklist:            c.StringSlice("blacklist"),
-				match:                c.String("match"),
-				silentMode:           c.Bool("silent-mode"),
-				doNotRemoveTmpFolder: c.Bool("do-not-remove-tmp-folder"),
-				noExec:               c.Bool("no-exec"),
-				execTimeout:          c.Uint("exec-timeout"),
-				importingOpts: importing.Options{
-					SkipFileWithoutTest:  c.Bool("skip-without-test"),
-					SkipFileWithBuildTag: c.Bool("skip-with-build-tags"),
-					GitMainBranch:        c.String("git-branch"),
-					ExcludeDirs:          c.StringArgs("exclude-dirs"),
-				},
-				exitCodeOnSurvivals: c.Bool("error-on-survivals"),
-				debug:               c.Bool("debug"),
-				verbose:             c.Bool("verbose"),
-				jsonOutput:          c.Bool("json_output"),
-			})
+			suite, err := newSuite(suiteOptions(c))
 			if err != nil {
 				return fmt.Errorf("prepare mutation framework: %w", err)
 			}
@@ -216,50 +242,94 @@ type options struct {
 	args                 []string
 	importingOpts        importing.Options
 	disabledMutators     []string
+	stdlibSwaps          []string
 	blacklist            []string
+	ignoreFile           string
 	match                string
 	silentMode           bool
 	testRecursive        bool
 	doNotRemoveTmpFolder bool
 	noExec               bool
 	execTimeout          uint
+	timeoutKilled        bool
 	jsonOutput           bool
 	exitCodeOnSurvivals  bool
 	debug                bool
 	verbose              bool
 }
 
+// suiteOptions collects suite [options] from the command flags.
+func suiteOptions(c *cli.Command) options {
+	return options{
+		args:                 c.Args().Slice(),
+		disabledMutators:     c.StringSlice("disable"),
+		stdlibSwaps:          c.StringSlice("stdlib-swap"),
+		blacklist:            c.StringSlice("blacklist"),
+		ignoreFile:           c.String("ignore-file"),
+		match:                c.String("match"),
+		silentMode:           c.Bool("silent-mode"),
+		doNotRemoveTmpFolder: c.Bool("do-not-remove-tmp-folder"),
+		noExec:               c.Bool("no-exec"),
+		execTimeout:          c.Uint("exec-timeout"),
+		timeoutKilled:        c.Bool("timeout-killed"),
+		importingOpts:        importingOptions(c),
+		exitCodeOnSurvivals:  c.Bool("error-on-survivals"),
+		debug:                c.Bool("debug"),
+		verbose:              c.Bool("verbose"),
+		jsonOutput:           c.Bool("json-output"),
+	}
+}
+
+// importingOptions collects [importing.Options] from the command flags.
+func importingOptions(c *cli.Command) importing.Options {
+	return importing.Options{
+		SkipFileWithoutTest:  c.Bool("skip-without-test"),
+		SkipFileWithBuildTag: c.Bool("skip-with-build-tags"),
+		GitMainBranch:        c.String("git-branch"),
+		Exclude:              slices.Concat(c.StringSlice("exclude"), c.StringSlice("exclude-dirs")),
+		IncludeGenerated:     c.Bool("include-generated"),
+	}
+}
+
 // suite allows to execute mutations.
 type suite struct {
 	opts      options
 	checksums map[string]struct{}
+	ignored   map[string]struct{}
 	mutators  []mutatorItem
 }
 
 // newSuite creates a new [suite].
 func newSuite(opts options) (*suite, error) {
-	checksums, err := loadChecksums(opts.blacklist)
+	checksums, ignored, err := loadIgnores(opts.blacklist, opts.ignoreFile)
 	if err != nil {
-		return nil, fmt.Errorf("load checksums: %w", err)
+		return nil, fmt.Errorf("load ignores: %w", err)
+	}
+	for _, swap := range opts.stdlibSwaps {
+		if err := stdlib.AddSwap(swap); err != nil {
+			return nil, fmt.Errorf("add stdlib swap: %w", err)
+		}
 	}
-	mutators, err := loadMutators(opts.disabledMutators)
+	mutators, err := loadMutators(opts.disabledMutators, opts.timeoutKilled)
 	if err != nil {
 		return nil, fmt.Errorf("load mutators: %w", err)
 	}
 	return &suite{
 		opts:      opts,
 		checksums: checksums,
+		ignored:   ignored,
 		mutators:  mutators,
 	}, nil
 }
 
-// loadChecksums loads files with blacklisted md5 checksums.
-func loadChecksums(files []string) (map[string]struct{}, error) {
-	checksums := make(map[string]struct{}, len(files))
-	for _, f := range files {
+// loadIgnores loads mutants which must not be executed. It returns md5 checksums of mutations from the blacklist files
+// and IDs of mutants marked as equivalent in the ignore file.
+func loadIgnores(blacklist []string, ignoreFile string) (checksums, ids map[string]struct{}, err error) {
+	checksums = make(map[string]struct{}, len(blacklist))
+	for _, f := range blacklist {
 		c, err := os.ReadFile(f)
 		if err != nil {
-			return nil, fmt.Errorf("read blacklist file %q: %w", f, err)
+			return nil, nil, fmt.Errorf("read blacklist file %q: %w", f, err)
 		}
 
 		for line := range strings.SplitSeq(string(c), "\n") {
@@ -268,17 +338,67 @@ func loadChecksums(files []string) (map[string]struct{}, error) {
 			}
 
 			if len(line) < md5Len {
-				return nil, fmt.Errorf("%q is not a MD5 checksum", line)
+				return nil, nil, fmt.Errorf("%q is not a MD5 checksum", line)
 			}
 
 			// Use the first 32 chars. Everything else is considered as a comment.
 			checksums[line[:md5Len]] = struct{}{}
 		}
 	}
-	return checksums, nil
+
+	if ignoreFile == "" {
+		return checksums, map[string]struct{}{}, nil
+	}
+	f, err := ignore.Load(ignoreFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("load ignore file: %w", err)
+	}
+	return checksums, f.Equivalent(), nil
+}
+
+// isIgnored reports whether the mutant is ignored by the ignore file.
+func (s *suite) isIgnored(m *mutant) bool {
+	_, ok := s.ignored[m.ID]
+	return ok
+}
+
+// isDuplicate reports whether the mutant duplicates an already seen mutant.
+func (s *suite) isDuplicate(m *mutant) bool {
+	if _, ok := s.checksums[m.Checksum]; ok {
+		return true
+	}
+	s.checksums[m.Checksum] = struct{}{}
+	return false
+}
+
+// nonTerminatingMutators can produce mutants which never terminate, e.g. loops with a "true" condition. Such mutants
+// only reach the exec timeout, so the mutators are disabled unless timed out mutants are counted as killed.
+var nonTerminatingMutators = []string{"branch/condition_loop"}
+
+// loadRules registers the custom mutators declared by the rules of the config file.
+func loadRules(configFile string) error {
+	if configFile == "" {
+		return nil
+	}
+	data, err := os.ReadFile(configFile)
+	if err != nil {
+		return fmt.Errorf("read config: %w", err)
+	}
+
+	var config struct {
+		Rules []rule.Rule `yaml:"rules"`
+	}
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return fmt.Errorf("unmarshal %q: %w", configFile, err)
+	}
+	return rule.Register(config.Rules...)
 }
 
-func loadMutators(disabledMutators []string) ([]mutatorItem, error) {
+func loadMutators(disabledMutators []string, timeoutKilled bool) ([]mutatorItem, error) {
+	if !timeoutKilled {
+		disabledMutators = slices.Concat(disabledMutators, nonTerminatingMutators)
+	}
+
 	var mutators []mutatorItem
 	for _, name := range mutator.List() {
 		if slices.ContainsFunc(disabledMutators, func(d string) bool {
@@ -350,30 +470,19 @@ func (s *suite) ExecuteMutesting(ctx context.Context) (*report.Report, error) {
 	for _, file := range files {
 		slog.Info("mutate", slog.String("file", file))
 
-		src, pkg, err := importing.ParseAndTypeCheckFile(ctx, file)
-		if err != nil {
-			return rep, fmt.Errorf("parse file: %w", err)
-		}
-
 		if err := os.MkdirAll(filepath.Join(tmpDir, filepath.Dir(file)), 0755); err != nil {
 			return nil, fmt.Errorf("copy files in temp directory: %w", err)
 		}
 
-		var mutationID int
-
-		if s.opts.match != "" {
-			m, err := regexp.Compile(s.opts.match)
-			if err != nil {
-				return nil, fmt.Errorf("match regex is not valid: %w", err)
-			}
+		mutants, err := s.mutants(ctx, file)
+		if err != nil {
+			return rep, err
+		}
 
-			for _, f := range astutil.Functions(src) {
-				if m.MatchString(f.Name.Name) {
-					mutationID = s.mutate(ctx, mutationID, pkg, file, src, f, tmpDir, rep)
-				}
-			}
-		} else {
-			_ = s.mutate(ctx, mutationID, pkg, file, src, src, tmpDir, rep)
+		var mutationID int
+		for m := range mutants {
+			s.mutate(ctx, mutationID, m, tmpDir, rep)
+			mutationID++
 		}
 	}
 
@@ -389,112 +498,98 @@ func (s *suite) ExecuteMutesting(ctx context.Context) (*report.Report, error) {
 	return rep, nil
 }
 
-func (s *suite) mutate(
-	ctx context.Context,
-	mutationID int,
-	pkg *packages.Package,
-	originalFile string,
-	src *ast.File,
-	node ast.Node,
-	tempDir string,
-	stats *report.Report,
-) int {
-	skippedLines := importing.Skips(pkg.Fset, src)
-
-	originalSourceCode, err := os.ReadFile(originalFile)
-	if err != nil {
-		log.Fatal(err)
+func (s *suite) mutate(ctx context.Context, mutationID int, m *mutant, tempDir string, stats *report.Report) {
+	mutant := report.Mutant{
+		ID: m.ID,
+		Mutator: report.Mutator{
+			MutatorName:        m.Mutator,
+			OriginalFilePath:   m.File,
+			OriginalSourceCode: string(m.Original),
+			OriginalStartLine:  int64(m.Pos.Line),
+		},
 	}
 
-	for _, mut := range s.mutators {
-		log.Printf("Mutator %s", mut.Name)
+	mutationFile := filepath.Join(tempDir, fmt.Sprintf("%s.%d", m.File, mutationID))
+	if s.isIgnored(m) {
+		log.Printf("%q is ignored by the ignore file, we ignore it", mutationFile)
 
-		mutesting.MutateWalk(pkg, node, mut.Mutator, skippedLines, func() {
-			mutant := report.Mutant{Mutator: report.Mutator{
-				MutatorName:        mut.Name,
-				OriginalFilePath:   originalFile,
-				OriginalSourceCode: string(originalSourceCode),
-			}}
+		stats.Stats.IgnoredCount++
+		return
+	}
+	if s.isDuplicate(m) {
+		log.Printf("%q is a duplicate, we ignore it", mutationFile)
 
-			mutationFile := filepath.Join(tempDir, fmt.Sprintf("%s.%d", originalFile, mutationID))
-			checksum, duplicate, err := s.saveAST(mutationFile, pkg.Fset, src)
-			if err != nil {
-				slog.Error("save ast", slog.String("file", mutationFile), slog.Any("error", err))
-			} else if duplicate {
-				log.Printf("%q is a duplicate, we ignore it", mutationFile)
-
-				stats.Stats.DuplicatedCount++
-			} else {
-				log.Printf("Save mutation into %q with checksum %s", mutationFile, checksum)
-
-				if !s.opts.noExec {
-					mutatedSourceCode, err := os.ReadFile(mutationFile)
-					if err != nil {
-						log.Fatal(err)
-					}
-					mutant.Mutator.MutatedSourceCode = string(mutatedSourceCode)
-
-					mutationError := s.mutateExec(ctx, pkg.Types, originalFile, mutationFile, &mutant)
-
-					if mutationError != nil {
-						slog.Info("exec mutation", slog.Any("error", mutationError))
-					}
-
-					msg := fmt.Sprintf("%q #%d with checksum %s", originalFile, mutationID, checksum)
-
-					switch {
-					case mutationError == nil: // Tests failed - all ok
-						out := fmt.Sprintf("PASS %s\n", msg)
-						if !s.opts.silentMode {
-							fmt.Println(color.GreenString("✓ PASS"), msg)
-						}
-
-						mutant.ProcessOutput = out
-						stats.Killed = append(stats.Killed, mutant)
-						stats.Stats.KilledCount++
-					case errors.Is(mutationError, execute.ErrMutationSurvived): // Tests passed
-						out := fmt.Sprintf("FAIL %s\n", msg)
-						if !s.opts.silentMode {
-							fmt.Println(color.RedString("✗ FAIL"), msg)
-						}
-
-						mutant.ProcessOutput = out
-						stats.Escaped = append(stats.Escaped, mutant)
-						stats.Stats.EscapedCount++
-					case errors.Is(mutationError, execute.ErrCompilationError),
-						errors.Is(mutationError, context.DeadlineExceeded): // Did not compile
-						out := fmt.Sprintf("SKIP %s\n", msg)
-						if !s.opts.silentMode {
-							fmt.Println("~ SKIP", msg)
-						}
-
-						mutant.ProcessOutput = out
-						stats.Stats.SkippedCount++
-					case errors.Is(mutationError, context.Canceled): // Cancel
-						slog.Warn("cancel signal received, exiting now")
-						os.Exit(1)
-					default:
-						out := fmt.Sprintf("UNKOWN exit code for %s: %s\n", msg, mutationError)
-						if !s.opts.silentMode {
-							fmt.Print(out)
-						}
-
-						mutant.ProcessOutput = out
-						stats.Errored = append(stats.Errored, mutant)
-						stats.Stats.ErrorCount++
-					}
-				}
-			}
-			mutationID++
-		}, func() {})
+		stats.Stats.DuplicatedCount++
+		return
+	}
+
+	if err := os.WriteFile(mutationFile, m.Mutated, 0666); err != nil {
+		slog.Error("save mutation", slog.String("file", mutationFile), slog.Any("error", err))
+		return
+	}
+	log.Printf("Save mutation into %q with checksum %s", mutationFile, m.Checksum)
+
+	if s.opts.noExec {
+		return
+	}
+
+	mutant.Mutator.MutatedSourceCode = string(m.Mutated)
+
+	mutationError := s.mutateExec(ctx, m.Package, m.File, mutationFile, &mutant)
+
+	if mutationError != nil {
+		slog.Info("exec mutation", slog.Any("error", mutationError))
 	}
 
-	return mutationID
+	msg := fmt.Sprintf("%q #%d (%s) with checksum %s", m.File, mutationID, m.ID, m.Checksum)
+
+	switch {
+	case mutationError == nil, // Tests failed - all ok
+		s.opts.timeoutKilled && errors.Is(mutationError, context.DeadlineExceeded): // Tests did not terminate
+		out := fmt.Sprintf("PASS %s\n", msg)
+		if !s.opts.silentMode {
+			fmt.Println(color.GreenString("✓ PASS"), msg)
+		}
+
+		mutant.ProcessOutput = out
+		stats.Killed = append(stats.Killed, mutant)
+		stats.Stats.KilledCount++
+	case errors.Is(mutationError, execute.ErrMutationSurvived): // Tests passed
+		out := fmt.Sprintf("FAIL %s\n", msg)
+		if !s.opts.silentMode {
+			fmt.Println(color.RedString("✗ FAIL"), msg)
+		}
+
+		mutant.ProcessOutput = out
+		stats.Escaped = append(stats.Escaped, mutant)
+		stats.Stats.EscapedCount++
+	case errors.Is(mutationError, execute.ErrCompilationError),
+		errors.Is(mutationError, context.DeadlineExceeded): // Did not compile
+		out := fmt.Sprintf("SKIP %s\n", msg)
+		if !s.opts.silentMode {
+			fmt.Println("~ SKIP", msg)
+		}
+
+		mutant.ProcessOutput = out
+		stats.Stats.SkippedCount++
+	case errors.Is(mutationError, context.Canceled): // Cancel
+		slog.Warn("cancel signal received, exiting now")
+		os.Exit(1)
+	default:
+		out := fmt.Sprintf("UNKOWN exit code for %s: %s\n", msg, mutationError)
+		if !s.opts.silentMode {
+			fmt.Print(out)
+		}
+
+		mutant.ProcessOutput = out
+		stats.Errored = append(stats.Errored, mutant)
+		stats.Stats.ErrorCount++
+	}
 }
 
 func (s *suite) mutateExec(
 	ctx context.Context,
-	pkg *types.Package,
+	pkg *packages.Package,
 	file string,
 	mutationFile string,
 	mutant *report.Mutant,
@@ -504,43 +599,18 @@ func (s *suite) mutateExec(
 
 	log.Printf("Execute built-in exec command for mutation")
 
+	var moduleDir string
+	if pkg.Module != nil {
+		moduleDir = pkg.Module.Dir
+	}
+
 	return execute.GoTest(ctx, mutant, execute.GoTestOptions{
 		Changed:       mutationFile,
 		Original:      file,
-		PackagePath:   pkg.Path(),
+		PackagePath:   pkg.PkgPath,
+		ModuleDir:     moduleDir,
 		Debug:         s.opts.debug,
 		SilentMode:    s.opts.silentMode,
 		TestRecursive: s.opts.testRecursive,
 	})
 }
-
-func (s *suite) saveAST(file string, fset *token.FileSet, node ast.Node) (string, bool, error) {
-	var buf bytes.Buffer
-
-	h := md5.New()
-
-	err := printer.Fprint(io.MultiWriter(h, &buf), fset, node)
-	if err != nil {
-		return "", false, err
-	}
-
-	checksum := hex.EncodeToString(h.Sum(nil))
-
-	if _, ok := s.checksums[checksum]; ok {
-		return checksum, true, nil
-	}
-
-	s.checksums[checksum] = struct{}{}
-
-	src, err := format.Source(buf.Bytes())
-	if err != nil {
-		return "", false, err
-	}
-
-	err = os.WriteFile(file, src, 0666)
-	if err != nil {
-		return "", false, err
-	}
-
-	return checksum, false, nil
-}
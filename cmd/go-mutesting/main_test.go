@@ -1,14 +1,22 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
+	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/leonidboykov/go-mutesting/internal/ignore"
 	"github.com/leonidboykov/go-mutesting/internal/importing"
 	"github.com/leonidboykov/go-mutesting/internal/report"
+	"github.com/leonidboykov/go-mutesting/mutator"
 )
 
 func TestExecuteMutesting(t *testing.T) {
@@ -25,20 +33,20 @@ func TestExecuteMutesting(t *testing.T) {
 			root:          "../../example",
 			opts:          options{execTimeout: 10},
 			expectedErr:   "",
-			expectedStats: report.Stats{Msi: 0.573770, KilledCount: 35, EscapedCount: 26, DuplicatedCount: 7, SkippedCount: 0, TotalMutantsCount: 61},
+			expectedStats: report.Stats{Msi: 0.623188, KilledCount: 86, EscapedCount: 52, DuplicatedCount: 30, SkippedCount: 0, TotalMutantsCount: 138},
 		},
 		{
 			name:          "recursive",
 			root:          "../../example",
 			opts:          options{args: []string{"./..."}, execTimeout: 10},
 			expectedErr:   "",
-			expectedStats: report.Stats{Msi: 0.600000, KilledCount: 39, EscapedCount: 26, DuplicatedCount: 7, SkippedCount: 0, TotalMutantsCount: 65},
+			expectedStats: report.Stats{Msi: 0.643836, KilledCount: 94, EscapedCount: 52, DuplicatedCount: 31, SkippedCount: 0, TotalMutantsCount: 146},
 		},
 		{
 			name:          "from other directory",
 			root:          "../..",
 			opts:          options{args: []string{"github.com/leonidboykov/go-mutesting/example"}, execTimeout: 10},
-			expectedStats: report.Stats{Msi: 0.573770, KilledCount: 35, EscapedCount: 26, DuplicatedCount: 7, SkippedCount: 0, TotalMutantsCount: 61},
+			expectedStats: report.Stats{Msi: 0.623188, KilledCount: 86, EscapedCount: 52, DuplicatedCount: 30, SkippedCount: 0, TotalMutantsCount: 138},
 			expectedErr:   "",
 		},
 		{
@@ -48,7 +56,7 @@ func TestExecuteMutesting(t *testing.T) {
 				SkipFileWithoutTest:  true,
 				SkipFileWithBuildTag: true,
 			}},
-			expectedStats: report.Stats{Msi: 0.583333, KilledCount: 35, EscapedCount: 25, DuplicatedCount: 7, SkippedCount: 0, TotalMutantsCount: 60},
+			expectedStats: report.Stats{Msi: 0.656489, KilledCount: 86, EscapedCount: 45, DuplicatedCount: 30, SkippedCount: 0, TotalMutantsCount: 131},
 			expectedErr:   "",
 		},
 	}
@@ -77,3 +85,225 @@ func TestExecuteMutesting(t *testing.T) {
 		})
 	}
 }
+
+func TestListMutants(t *testing.T) {
+	saveCwd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir("../../example"))
+	t.Cleanup(func() { os.Chdir(saveCwd) })
+
+	s, err := newSuite(options{})
+	require.NoError(t, err)
+	list, err := s.listMutants(t.Context())
+	require.NoError(t, err)
+
+	// The numbers must match the execution of the "simple" case of TestExecuteMutesting.
+	assert.Equal(t, 138, list.Total)
+	assert.Equal(t, 30, list.Duplicated)
+	assert.Len(t, list.Mutants, 138)
+	assert.Equal(t, map[string]int{"github.com/leonidboykov/go-mutesting/example": 138}, list.Packages)
+
+	ids := make(map[string]struct{})
+	for _, m := range list.Mutants {
+		ids[m.ID] = struct{}{}
+	}
+	assert.Len(t, ids, 138, "mutant IDs must be unique")
+}
+
+func TestApplyMutant(t *testing.T) {
+	root := t.TempDir()
+	original := "package tmp\n\nfunc sum() int {\n\treturn 1 + 2\n}\n"
+	require.NoError(t, os.WriteFile(filepath.Join(root, "go.mod"), []byte("module tmp\n\ngo 1.26.2\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "sum.go"), []byte(original), 0644))
+
+	saveCwd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(root))
+	t.Cleanup(func() { os.Chdir(saveCwd) })
+
+	s, err := newSuite(options{disabledMutators: []string{"numbers/*"}})
+	require.NoError(t, err)
+	list, err := s.listMutants(t.Context())
+	require.NoError(t, err)
+	require.NotEmpty(t, list.Mutants)
+
+	m, err := s.findMutant(t.Context(), list.Mutants[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, list.Mutants[0].Checksum, m.Checksum)
+
+	_, err = s.findMutant(t.Context(), "unknown")
+	assert.EqualError(t, err, `mutant "unknown" not found`)
+
+	stateDir := t.TempDir()
+
+	// Mutants which cannot be written do not leave a state behind.
+	unwritable := *m
+	unwritable.File = root
+	require.Error(t, applyMutant(&unwritable, stateDir))
+	assert.NoFileExists(t, filepath.Join(stateDir, m.ID+".json"))
+
+	require.NoError(t, applyMutant(m, stateDir))
+	assert.EqualError(t, applyMutant(m, stateDir), fmt.Sprintf("mutant %s is already applied", m.ID))
+
+	mutated, err := os.ReadFile(filepath.Join(root, "sum.go"))
+	require.NoError(t, err)
+	assert.Equal(t, "package tmp\n\nfunc sum() int {\n\treturn 1 - 2\n}\n", string(mutated))
+
+	file, err := revertMutant(m.ID, stateDir, false)
+	require.NoError(t, err)
+	assert.Equal(t, m.File, file)
+
+	reverted, err := os.ReadFile(filepath.Join(root, "sum.go"))
+	require.NoError(t, err)
+	assert.Equal(t, original, string(reverted))
+
+	_, err = revertMutant(m.ID, stateDir, false)
+	assert.EqualError(t, err, fmt.Sprintf("mutant %s is not applied", m.ID))
+}
+
+func TestTriage(t *testing.T) {
+	ignoreFile := filepath.Join(t.TempDir(), "ignore.yml")
+	date := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	f := new(ignore.File)
+	f.Set(ignore.Entry{ID: "done", Status: ignore.StatusEquivalent, Date: date})
+
+	var opened []string
+	var out bytes.Buffer
+	tr := &triager{
+		// Skip the first mutant, retry without a reason, open the second one and mark it as equivalent, postpone
+		// the third one and quit on the last one.
+		in:         bufio.NewReader(strings.NewReader("s\ne\n\no\ne\nearly exit\np\n\nq\n")),
+		out:        &out,
+		author:     "gopher",
+		now:        func() time.Time { return date },
+		ignoreFile: ignoreFile,
+		edit: func(file string, line int64) error {
+			opened = append(opened, fmt.Sprintf("%s:%d", file, line))
+			return nil
+		},
+	}
+	mutant := func(id string) report.Mutant {
+		return report.Mutant{ID: id, Mutator: report.Mutator{MutatorName: "m", OriginalFilePath: "/a.go", OriginalStartLine: 1}}
+	}
+	require.NoError(t, tr.run([]report.Mutant{
+		mutant("done"), mutant("first"), mutant("second"), mutant("third"), mutant("fourth"),
+	}, f))
+
+	assert.Equal(t, []string{"/a.go:1"}, opened)
+	assert.Contains(t, out.String(), "A reason is required for equivalent mutants.")
+
+	saved, err := ignore.Load(ignoreFile)
+	require.NoError(t, err)
+	assert.Equal(t, []ignore.Entry{
+		{ID: "done", Status: ignore.StatusEquivalent, Date: date},
+		{ID: "second", Status: ignore.StatusEquivalent, Reason: "early exit", Author: "gopher", Date: date, Mutator: "m", File: "/a.go", Line: 1},
+		{ID: "third", Status: ignore.StatusPostponed, Author: "gopher", Date: date, Mutator: "m", File: "/a.go", Line: 1},
+	}, saved.Mutants)
+}
+
+func TestIgnoreFile(t *testing.T) {
+	saveCwd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir("../../example"))
+	t.Cleanup(func() { os.Chdir(saveCwd) })
+
+	s, err := newSuite(options{})
+	require.NoError(t, err)
+	list, err := s.listMutants(t.Context())
+	require.NoError(t, err)
+
+	ignoreFile := filepath.Join(t.TempDir(), "ignore.yml")
+	f := new(ignore.File)
+	f.Set(ignore.Entry{ID: list.Mutants[0].ID, Status: ignore.StatusEquivalent})
+	f.Set(ignore.Entry{ID: list.Mutants[1].ID, Status: ignore.StatusPostponed})
+	require.NoError(t, f.Save(ignoreFile))
+
+	s, err = newSuite(options{ignoreFile: ignoreFile})
+	require.NoError(t, err)
+	ignored, err := s.listMutants(t.Context())
+	require.NoError(t, err)
+
+	assert.Equal(t, list.Total-1, ignored.Total)
+	assert.Equal(t, list.Duplicated, ignored.Duplicated)
+	assert.Equal(t, 1, ignored.Ignored)
+	assert.Equal(t, list.Mutants[1:], ignored.Mutants)
+}
+
+func TestLoadMutatorsTimeoutKilled(t *testing.T) {
+	names := func(items []mutatorItem) []string {
+		var names []string
+		for _, item := range items {
+			names = append(names, item.Name)
+		}
+		return names
+	}
+
+	mutators, err := loadMutators(nil, false)
+	require.NoError(t, err)
+	assert.NotContains(t, names(mutators), "branch/condition_loop")
+	assert.Contains(t, names(mutators), "branch/condition")
+
+	mutators, err = loadMutators(nil, true)
+	require.NoError(t, err)
+	assert.Contains(t, names(mutators), "branch/condition_loop")
+
+	mutators, err = loadMutators([]string{"branch/*"}, true)
+	require.NoError(t, err)
+	assert.NotContains(t, names(mutators), "branch/condition_loop")
+}
+
+func TestLoadRules(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.yml")
+	require.NoError(t, os.WriteFile(configFile, []byte(`rules:
+  - name: equal_fold
+    pattern: strings.EqualFold(a, b)
+    replacement: a == b
+    vars:
+      a: string
+      b: string
+`), 0644))
+
+	require.NoError(t, loadRules(""))
+	require.NoError(t, loadRules(configFile))
+	assert.Contains(t, mutator.List(), "rule/equal_fold")
+
+	mutators, err := loadMutators([]string{"rule/*"}, false)
+	require.NoError(t, err)
+	for _, m := range mutators {
+		assert.NotEqual(t, "rule/equal_fold", m.Name)
+	}
+}
+
+func TestMutantIDStable(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, "go.mod"), []byte("module tmp\n\ngo 1.26.2\n"), 0644))
+	write := func(src string) {
+		require.NoError(t, os.WriteFile(filepath.Join(root, "sum.go"), []byte(src), 0644))
+	}
+
+	saveCwd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(root))
+	t.Cleanup(func() { os.Chdir(saveCwd) })
+
+	s, err := newSuite(options{disabledMutators: []string{"numbers/*"}})
+	require.NoError(t, err)
+	ids := func() []string {
+		list, err := s.listMutants(t.Context())
+		require.NoError(t, err)
+		var ids []string
+		for _, m := range list.Mutants {
+			ids = append(ids, m.ID)
+		}
+		return ids
+	}
+
+	write("package tmp\n\nfunc sum() int {\n\treturn 1 + 2\n}\n")
+	original := ids()
+	require.NotEmpty(t, original)
+
+	// Changes outside of the mutated function keep the IDs.
+	write("package tmp\n\n// sum sums.\nfunc sum() int {\n\treturn 1 + 2\n}\n\nfunc other() {}\n")
+	assert.Subset(t, ids(), original)
+}
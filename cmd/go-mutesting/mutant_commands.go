@@ -0,0 +1,219 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/leonidboykov/go-mutesting/internal/diff"
+	"github.com/leonidboykov/go-mutesting/internal/importing"
+)
+
+var showCommand = &cli.Command{
+	Name:      "show",
+	Usage:     "Print the diff and metadata of a mutant",
+	ArgsUsage: "<mutant-id> [packages]",
+	Action: func(ctx context.Context, c *cli.Command) error {
+		m, err := findMutantOfArgs(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		d, err := diff.CompareStrings(string(m.Original), string(m.Mutated), m.Mutator)
+		if err != nil {
+			return fmt.Errorf("compare files: %w", err)
+		}
+
+		fmt.Printf("ID:       %s\n", m.ID)
+		fmt.Printf("Mutator:  %s\n", m.Mutator)
+		fmt.Printf("Package:  %s\n", m.Package.PkgPath)
+		fmt.Printf("Position: %s:%d:%d\n", displayPath(m.File), m.Pos.Line, m.Pos.Column)
+		fmt.Printf("Checksum: %s\n", m.Checksum)
+		fmt.Printf("Change:   %s\n", m.Description)
+		fmt.Println()
+		fmt.Print(d)
+		return nil
+	},
+}
+
+var applyCommand = &cli.Command{
+	Name:      "apply",
+	Usage:     "Write a mutant into the working tree",
+	ArgsUsage: "<mutant-id> [packages]",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "revert",
+			Usage: "restore the original source code of a previously applied mutant",
+		},
+		&cli.BoolFlag{
+			Name:  "force",
+			Usage: "revert even if the mutated file was changed after the mutant was applied",
+		},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		stateDir, err := appliedStateDir()
+		if err != nil {
+			return err
+		}
+
+		if c.Bool("revert") {
+			if c.Args().Len() == 0 {
+				return errors.New("mutant ID is required")
+			}
+			file, err := revertMutant(c.Args().First(), stateDir, c.Bool("force"))
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Reverted mutant %s in %s\n", c.Args().First(), displayPath(file))
+			return nil
+		}
+
+		m, err := findMutantOfArgs(ctx, c)
+		if err != nil {
+			return err
+		}
+		if err := applyMutant(m, stateDir); err != nil {
+			return err
+		}
+		fmt.Printf("Applied mutant %s to %s:%d, revert with: go-mutesting apply --revert %s\n",
+			m.ID, displayPath(m.File), m.Pos.Line, m.ID)
+		return nil
+	},
+}
+
+// findMutantOfArgs looks for a mutant with an ID given as the first argument. The rest of arguments define the
+// targets to search in, all packages of the working directory are searched by default.
+func findMutantOfArgs(ctx context.Context, c *cli.Command) (*mutant, error) {
+	if c.Args().Len() == 0 {
+		return nil, errors.New("mutant ID is required")
+	}
+
+	opts := suiteOptions(c)
+	opts.args = c.Args().Tail()
+	if len(opts.args) == 0 {
+		opts.args = []string{"./..."}
+	}
+
+	s, err := newSuite(opts)
+	if err != nil {
+		return nil, fmt.Errorf("prepare mutation framework: %w", err)
+	}
+	return s.findMutant(ctx, c.Args().First())
+}
+
+// findMutant generates mutants exactly as the execution does until the mutant with the given ID is found.
+func (s *suite) findMutant(ctx context.Context, id string) (*mutant, error) {
+	files, err := importing.FilesOfArgs(ctx, s.opts.args, s.opts.importingOpts)
+	if err != nil {
+		return nil, fmt.Errorf("load packages: %w", err)
+	}
+
+	for _, file := range files {
+		mutants, err := s.mutants(ctx, file)
+		if err != nil {
+			return nil, err
+		}
+		for m := range mutants {
+			if m.ID == id {
+				return m, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("mutant %q not found", id)
+}
+
+// appliedMutant is a record of a mutant written into the working tree.
+type appliedMutant struct {
+	File     string `json:"file"`
+	Original []byte `json:"original"`
+	Mutated  []byte `json:"mutated"`
+}
+
+// appliedStateDir returns a directory to keep records of applied mutants.
+func appliedStateDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("get cache directory: %w", err)
+	}
+	return filepath.Join(dir, "go-mutesting", "applied"), nil
+}
+
+// applyMutant writes the mutant into its file and records the original source code in the state directory.
+func applyMutant(m *mutant, stateDir string) error {
+	statePath := filepath.Join(stateDir, m.ID+".json")
+	if exists(statePath) {
+		return fmt.Errorf("mutant %s is already applied", m.ID)
+	}
+
+	info, err := os.Stat(m.File)
+	if err != nil {
+		return fmt.Errorf("stat file: %w", err)
+	}
+
+	state, err := json.Marshal(appliedMutant{File: m.File, Original: m.Original, Mutated: m.Mutated})
+	if err != nil {
+		return fmt.Errorf("marshal state: %w", err)
+	}
+	if err := os.MkdirAll(stateDir, 0755); err != nil {
+		return fmt.Errorf("create state directory: %w", err)
+	}
+	if err := os.WriteFile(statePath, state, 0644); err != nil {
+		return fmt.Errorf("write state: %w", err)
+	}
+
+	// The state is written first, so the original source code is recorded before the file is changed. It is removed
+	// again if the mutant cannot be written, so the mutant can be applied later.
+	if err := os.WriteFile(m.File, m.Mutated, info.Mode()); err != nil {
+		return errors.Join(fmt.Errorf("write mutant: %w", err), os.Remove(statePath))
+	}
+	return nil
+}
+
+// revertMutant restores the original source code of an applied mutant and returns the path of the restored file.
+func revertMutant(id, stateDir string, force bool) (string, error) {
+	statePath := filepath.Join(stateDir, id+".json")
+	data, err := os.ReadFile(statePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("mutant %s is not applied", id)
+	}
+	if err != nil {
+		return "", fmt.Errorf("read state: %w", err)
+	}
+
+	var state appliedMutant
+	if err := json.Unmarshal(data, &state); err != nil {
+		return "", fmt.Errorf("unmarshal state: %w", err)
+	}
+
+	current, err := os.ReadFile(state.File)
+	if err != nil {
+		return "", fmt.Errorf("read file: %w", err)
+	}
+	if !force && !bytes.Equal(current, state.Mutated) {
+		return "", fmt.Errorf("%s was changed after the mutant was applied, use --force to revert anyway", state.File)
+	}
+
+	info, err := os.Stat(state.File)
+	if err != nil {
+		return "", fmt.Errorf("stat file: %w", err)
+	}
+	if err := os.WriteFile(state.File, state.Original, info.Mode()); err != nil {
+		return "", fmt.Errorf("write original: %w", err)
+	}
+	if err := os.Remove(statePath); err != nil {
+		return "", fmt.Errorf("remove state: %w", err)
+	}
+	return state.File, nil
+}
+
+func exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
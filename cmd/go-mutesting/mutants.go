@@ -0,0 +1,241 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/printer"
+	"go/token"
+	"io"
+	"iter"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/leonidboykov/go-mutesting"
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/internal/importing"
+)
+
+// mutant is a single mutation of a file.
+type mutant struct {
+	// ID identifies the mutant. It depends only on the module-relative file path, the mutator, the enclosing function,
+	// the source code of the mutated node, the index of the mutation for the node and the occurrence of the same mutation
+	// in the function. Positions are not used, so the ID is stable between runs with different options and survives
+	// changes of the source code outside of the mutated node.
+	ID          string
+	Mutator     string
+	File        string
+	Pos         token.Position
+	Package     *packages.Package
+	Description string
+	// Checksum is the MD5 checksum of the mutated file as used by blacklists.
+	Checksum string
+	// Original and Mutated hold the original and the formatted mutated source code.
+	Original []byte
+	Mutated  []byte
+}
+
+// mutants parses the file and returns an iterator over its mutants generated by all enabled mutators. Only functions
+// matching the match option are mutated if it is set. Mutations which cannot be printed are logged and skipped.
+//
+// The mutation is applied to the syntax tree only until the yield function returns.
+func (s *suite) mutants(ctx context.Context, file string) (iter.Seq[*mutant], error) {
+	var match *regexp.Regexp
+	if s.opts.match != "" {
+		var err error
+		match, err = regexp.Compile(s.opts.match)
+		if err != nil {
+			return nil, fmt.Errorf("match regex is not valid: %w", err)
+		}
+	}
+
+	src, pkg, err := importing.ParseAndTypeCheckFile(ctx, file)
+	if err != nil {
+		return nil, fmt.Errorf("parse file: %w", err)
+	}
+
+	original, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("read file: %w", err)
+	}
+
+	nodes := []ast.Node{src}
+	if match != nil {
+		nodes = nil
+		for _, f := range astutil.Functions(src) {
+			if match.MatchString(f.Name.Name) {
+				nodes = append(nodes, f)
+			}
+		}
+	}
+
+	// Mutations are described against the printed original, so that formatting differences do not count as changes.
+	_, printed, err := printMutation(pkg.Fset, src)
+	if err != nil {
+		return nil, fmt.Errorf("print file: %w", err)
+	}
+
+	relFile := moduleRelPath(pkg, file)
+	skippedLines := importing.Skips(pkg.Fset, src)
+	funcs := astutil.Functions(src)
+
+	return func(yield func(*mutant) bool) {
+		occurrences := make(map[string]int)
+		for _, node := range nodes {
+			for _, mut := range s.mutators {
+				var (
+					prev  ast.Node
+					index int
+				)
+				for n, m := range mutesting.Mutations(pkg, node, mut.Mutator, skippedLines) {
+					if n == prev {
+						index++
+					} else {
+						prev, index = n, 0
+					}
+
+					pos := pkg.Fset.Position(n.Pos())
+					key := mutantKey(relFile, mut.Name, enclosingFunc(funcs, n), nodeSource(n), index)
+					occurrence := occurrences[key]
+					occurrences[key]++
+
+					m.Change()
+					checksum, mutated, err := printMutation(pkg.Fset, src)
+					if err != nil {
+						m.Reset()
+						slog.Error("print mutation", slog.String("pos", pos.String()), slog.String("mutator", mut.Name), slog.Any("error", err))
+						continue
+					}
+
+					ok := yield(&mutant{
+						ID:          mutantID(key, occurrence),
+						Mutator:     mut.Name,
+						File:        file,
+						Pos:         pos,
+						Package:     pkg,
+						Description: describeMutation(printed, mutated),
+						Checksum:    checksum,
+						Original:    original,
+						Mutated:     mutated,
+					})
+					m.Reset()
+					if !ok {
+						return
+					}
+				}
+			}
+		}
+	}, nil
+}
+
+// printMutation prints the mutated syntax tree. It returns the checksum of the printed tree and the formatted source.
+func printMutation(fset *token.FileSet, node ast.Node) (string, []byte, error) {
+	var buf bytes.Buffer
+
+	h := md5.New()
+
+	if err := printer.Fprint(io.MultiWriter(h, &buf), fset, node); err != nil {
+		return "", nil, err
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return "", nil, err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), src, nil
+}
+
+// mutantKey returns the key of a mutation, which is shared by equal mutations of equal nodes of the same function.
+func mutantKey(file, mutatorName, funcName, source string, index int) string {
+	return fmt.Sprintf("%s\x00%s\x00%s\x00%s\x00%d", filepath.ToSlash(file), mutatorName, funcName, source, index)
+}
+
+// mutantID computes a stable mutant identifier of the given occurrence of the mutation key.
+func mutantID(key string, occurrence int) string {
+	h := md5.Sum(fmt.Appendf(nil, "%s\x00%d", key, occurrence))
+	return hex.EncodeToString(h[:6])
+}
+
+// enclosingFunc returns the name of the function declaration containing the node, including the receiver type of
+// methods, e.g. "(*T).Name". An empty string is returned for nodes outside of functions.
+func enclosingFunc(funcs []*ast.FuncDecl, node ast.Node) string {
+	for _, f := range funcs {
+		if f.Pos() <= node.Pos() && node.End() <= f.End() {
+			if f.Recv == nil || len(f.Recv.List) == 0 {
+				return f.Name.Name
+			}
+			return fmt.Sprintf("(%s).%s", nodeSource(f.Recv.List[0].Type), f.Name.Name)
+		}
+	}
+	return ""
+}
+
+// nodeSource returns the source code of the node, which does not depend on its position in the file. Doc comments of
+// function declarations are left out, comments are not part of printed nodes otherwise.
+func nodeSource(node ast.Node) string {
+	if f, ok := node.(*ast.FuncDecl); ok && f.Doc != nil {
+		decl := *f
+		decl.Doc = nil
+		node = &decl
+	}
+
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, token.NewFileSet(), node); err != nil {
+		return ""
+	}
+	return buf.String()
+}
+
+// moduleRelPath returns the path of the file relative to the root of its module. The file path is returned as is if
+// the module is unknown.
+func moduleRelPath(pkg *packages.Package, file string) string {
+	if pkg.Module == nil {
+		return file
+	}
+	abs, err := filepath.Abs(file)
+	if err != nil {
+		return file
+	}
+	rel, err := filepath.Rel(pkg.Module.Dir, abs)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return file
+	}
+	return rel
+}
+
+// describeMutation returns a short description of the first changed line, e.g. "if n < 0 { → if n <= 0 {".
+func describeMutation(original, mutated []byte) string {
+	a := strings.Split(string(original), "\n")
+	b := strings.Split(string(mutated), "\n")
+
+	var prefix int
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	var suffix int
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var removed, added string
+	if prefix < len(a)-suffix {
+		removed = strings.TrimSpace(a[prefix])
+	}
+	if prefix < len(b)-suffix {
+		added = strings.TrimSpace(b[prefix])
+	}
+	if removed == "" && added == "" {
+		return "no visible change"
+	}
+	return removed + " → " + added
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/user"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/leonidboykov/go-mutesting/internal/ignore"
+	"github.com/leonidboykov/go-mutesting/internal/report"
+)
+
+var triageCommand = &cli.Command{
+	Name:  "triage",
+	Usage: "Walk through escaped mutants of a saved report and mark them as equivalent or postponed",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "report",
+			Usage: "report `FILE` written with --json-output",
+			Value: report.ReportFileName,
+		},
+		&cli.StringFlag{
+			Name:  "author",
+			Usage: "author of triage decisions (defaults to $GIT_AUTHOR_NAME or the current user)",
+		},
+		&cli.BoolFlag{
+			Name:  "postponed",
+			Usage: "also walk through postponed mutants",
+		},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		rep, err := report.ReadFile(c.String("report"))
+		if err != nil {
+			return fmt.Errorf("read report: %w", err)
+		}
+
+		ignoreFile := c.String("ignore-file")
+		if ignoreFile == "" {
+			return errors.New("ignore file is not set")
+		}
+		f, err := ignore.Load(ignoreFile)
+		if err != nil {
+			return fmt.Errorf("load ignore file: %w", err)
+		}
+
+		t := &triager{
+			in:            bufio.NewReader(os.Stdin),
+			out:           os.Stdout,
+			author:        cmp.Or(c.String("author"), os.Getenv("GIT_AUTHOR_NAME"), currentUser()),
+			now:           time.Now,
+			edit:          openEditor,
+			ignoreFile:    ignoreFile,
+			withPostponed: c.Bool("postponed"),
+		}
+		return t.run(rep.Escaped, f)
+	},
+}
+
+// triager asks the user for a decision on every mutant.
+type triager struct {
+	in            *bufio.Reader
+	out           io.Writer
+	author        string
+	now           func() time.Time
+	edit          func(file string, line int64) error
+	ignoreFile    string
+	withPostponed bool
+}
+
+// run walks through the mutants and saves the ignore file after every decision, so quitting keeps the progress.
+func (t *triager) run(mutants []report.Mutant, f *ignore.File) error {
+	var pending []report.Mutant
+	for _, m := range mutants {
+		if m.ID == "" {
+			fmt.Fprintf(t.out, "Skip mutant of %s without ID, the report is too old\n", m.Mutator.OriginalFilePath)
+			continue
+		}
+		if e, ok := f.Lookup(m.ID); ok && (e.Status == ignore.StatusEquivalent || !t.withPostponed) {
+			continue
+		}
+		pending = append(pending, m)
+	}
+	if len(pending) == 0 {
+		fmt.Fprintln(t.out, "Nothing to triage.")
+		return nil
+	}
+
+	for i, m := range pending {
+		fmt.Fprintf(t.out, "\n[%d/%d] %s %s %s:%d\n", i+1, len(pending),
+			m.ID, m.Mutator.MutatorName, displayPath(m.Mutator.OriginalFilePath), m.Mutator.OriginalStartLine)
+		fmt.Fprint(t.out, m.Diff)
+
+	PROMPT:
+		for {
+			answer, err := t.ask("(e)quivalent, (p)ostpone, (o)pen in $EDITOR, (s)kip, (q)uit: ")
+			if err != nil {
+				return err
+			}
+
+			switch answer {
+			case "e", "equivalent":
+				reason, err := t.ask("Reason: ")
+				if err != nil {
+					return err
+				}
+				if reason == "" {
+					fmt.Fprintln(t.out, "A reason is required for equivalent mutants.")
+					continue
+				}
+				if err := t.save(f, m, ignore.StatusEquivalent, reason); err != nil {
+					return err
+				}
+				break PROMPT
+			case "p", "postpone":
+				reason, err := t.ask("Reason (optional): ")
+				if err != nil {
+					return err
+				}
+				if err := t.save(f, m, ignore.StatusPostponed, reason); err != nil {
+					return err
+				}
+				break PROMPT
+			case "o", "open":
+				if err := t.edit(m.Mutator.OriginalFilePath, m.Mutator.OriginalStartLine); err != nil {
+					fmt.Fprintf(t.out, "Cannot open editor: %s\n", err)
+				}
+			case "s", "skip":
+				break PROMPT
+			case "q", "quit":
+				return nil
+			default:
+				fmt.Fprintf(t.out, "Unknown answer %q.\n", answer)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ask prints the prompt and reads a trimmed line. Closed input is reported as an error.
+func (t *triager) ask(prompt string) (string, error) {
+	fmt.Fprint(t.out, prompt)
+	line, err := t.in.ReadString('\n')
+	if err != nil && (!errors.Is(err, io.EOF) || line == "") {
+		return "", fmt.Errorf("read answer: %w", err)
+	}
+	return strings.TrimSpace(line), nil
+}
+
+func (t *triager) save(f *ignore.File, m report.Mutant, status ignore.Status, reason string) error {
+	f.Set(ignore.Entry{
+		ID:      m.ID,
+		Status:  status,
+		Reason:  reason,
+		Author:  t.author,
+		Date:    t.now().UTC().Truncate(time.Second),
+		Mutator: m.Mutator.MutatorName,
+		File:    displayPath(m.Mutator.OriginalFilePath),
+		Line:    m.Mutator.OriginalStartLine,
+	})
+	if err := f.Save(t.ignoreFile); err != nil {
+		return fmt.Errorf("save ignore file: %w", err)
+	}
+	return nil
+}
+
+// openEditor opens the file at the given line in $EDITOR.
+func openEditor(file string, line int64) error {
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		return errors.New("$EDITOR is not set")
+	}
+	args := strings.Fields(editor)
+	args = append(args, fmt.Sprintf("+%d", line), file)
+
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+func currentUser() string {
+	u, err := user.Current()
+	if err != nil {
+		return ""
+	}
+	return u.Username
+}
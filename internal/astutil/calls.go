@@ -0,0 +1,70 @@
+package astutil
+
+import (
+	"go/ast"
+	"go/types"
+	"slices"
+)
+
+// CalledFunc returns the function or method called by the call expression. The second return argument is false for
+// calls of builtins, function values and conversions.
+func CalledFunc(info *types.Info, call *ast.CallExpr) (*types.Func, bool) {
+	var ident *ast.Ident
+	switch fun := ast.Unparen(call.Fun).(type) {
+	case *ast.Ident:
+		ident = fun
+	case *ast.SelectorExpr:
+		ident = fun.Sel
+	case *ast.IndexExpr:
+		return CalledFunc(info, &ast.CallExpr{Fun: fun.X})
+	case *ast.IndexListExpr:
+		return CalledFunc(info, &ast.CallExpr{Fun: fun.X})
+	default:
+		return nil, false
+	}
+
+	fn, ok := info.Uses[ident].(*types.Func)
+	if !ok {
+		return nil, false
+	}
+	return fn.Origin(), true
+}
+
+// IsPackageFunc reports whether the call calls one of the named functions of the package with the given import path.
+func IsPackageFunc(info *types.Info, call *ast.CallExpr, path string, names ...string) bool {
+	fn, ok := CalledFunc(info, call)
+	if !ok || fn.Signature().Recv() != nil {
+		return false
+	}
+	return fn.Pkg() != nil && fn.Pkg().Path() == path && slices.Contains(names, fn.Name())
+}
+
+// IsMethod reports whether the call calls one of the named methods of the named type of the package with the given
+// import path. Methods promoted by embedding are reported too.
+func IsMethod(info *types.Info, call *ast.CallExpr, path, typeName string, names ...string) bool {
+	fn, ok := CalledFunc(info, call)
+	if !ok || fn.Signature().Recv() == nil || !slices.Contains(names, fn.Name()) {
+		return false
+	}
+
+	recv := fn.Signature().Recv().Type()
+	if p, ok := recv.(*types.Pointer); ok {
+		recv = p.Elem()
+	}
+	named, ok := recv.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == path && obj.Name() == typeName
+}
+
+// IsBuiltin reports whether the call calls the predeclared function with the given name, e.g. close or len.
+func IsBuiltin(info *types.Info, call *ast.CallExpr, name string) bool {
+	ident, ok := ast.Unparen(call.Fun).(*ast.Ident)
+	if !ok {
+		return false
+	}
+	b, ok := info.Uses[ident].(*types.Builtin)
+	return ok && b.Name() == name
+}
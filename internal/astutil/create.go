@@ -10,7 +10,8 @@ import (
 func CreateNoopOfStatements(pkg *types.Package, info *types.Info, stmts ...ast.Stmt) ast.Stmt {
 	var ids []ast.Expr
 	for _, stmt := range stmts {
-		ids = append(ids, IdentifiersInStatement(pkg, info, stmt)...)
+		// Objects declared by one of the statements are removed together with all of them.
+		ids = append(ids, identifiersIn(info, stmt, stmts[0].Pos(), stmts[len(stmts)-1].End())...)
 	}
 
 	if len(ids) == 0 {
@@ -30,3 +31,16 @@ func CreateNoopOfStatements(pkg *types.Package, info *types.Info, stmts ...ast.S
 		Tok: token.ASSIGN,
 	}
 }
+
+// CreateNegation creates a negation of a boolean expression. A negation is removed instead of being doubled.
+func CreateNegation(expr ast.Expr) ast.Expr {
+	switch x := expr.(type) {
+	case *ast.UnaryExpr:
+		if x.Op == token.NOT {
+			return x.X
+		}
+	case *ast.Ident, *ast.CallExpr, *ast.SelectorExpr, *ast.IndexExpr, *ast.IndexListExpr, *ast.ParenExpr:
+		return &ast.UnaryExpr{Op: token.NOT, X: x}
+	}
+	return &ast.UnaryExpr{Op: token.NOT, X: &ast.ParenExpr{X: expr}}
+}
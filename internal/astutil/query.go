@@ -4,11 +4,23 @@ import (
 	"go/ast"
 	"go/token"
 	"go/types"
+	"sync"
 )
 
-// IdentifiersInStatement returns all identifiers with their found in a statement.
+// IdentifiersInStatement returns all identifiers with their found in a statement. Identifiers of objects declared in
+// the statement, e.g. variables of function literals, are ignored, as they are removed together with the statement.
 func IdentifiersInStatement(pkg *types.Package, info *types.Info, stmt ast.Stmt) []ast.Expr {
+	return identifiersIn(info, stmt, stmt.Pos(), stmt.End())
+}
+
+// identifiersIn returns the identifiers of the statement like [IdentifiersInStatement], ignoring identifiers of
+// objects declared between the given positions.
+func identifiersIn(info *types.Info, stmt ast.Stmt, pos, end token.Pos) []ast.Expr {
 	var identifiers []ast.Expr
+	declaredInside := func(ident *ast.Ident) bool {
+		obj := info.ObjectOf(ident)
+		return obj != nil && pos <= obj.Pos() && obj.Pos() < end
+	}
 
 	ast.PreorderStack(stmt, nil, func(node ast.Node, _ []ast.Node) bool {
 		switch n := node.(type) {
@@ -23,6 +35,10 @@ func IdentifiersInStatement(pkg *types.Package, info *types.Info, stmt ast.Stmt)
 				return false
 			}
 
+			if declaredInside(n) {
+				return false
+			}
+
 			// We are only interested in variables
 			if obj, ok := info.Uses[n]; ok {
 				if _, ok := obj.(*types.Var); !ok {
@@ -37,7 +53,7 @@ func IdentifiersInStatement(pkg *types.Package, info *types.Info, stmt ast.Stmt)
 
 			return false
 		case *ast.SelectorExpr:
-			if !checkForSelectorExpr(n) {
+			if !checkForSelectorExpr(n) || declaredInside(selectorRoot(n)) {
 				return false
 			}
 
@@ -73,6 +89,20 @@ func IdentifiersInStatement(pkg *types.Package, info *types.Info, stmt ast.Stmt)
 	return identifiers
 }
 
+// selectorRoot returns the identifier a selector expression accepted by checkForSelectorExpr starts with.
+func selectorRoot(n *ast.SelectorExpr) *ast.Ident {
+	for {
+		switch x := n.X.(type) {
+		case *ast.Ident:
+			return x
+		case *ast.SelectorExpr:
+			n = x
+		default:
+			return nil
+		}
+	}
+}
+
 func checkForSelectorExpr(node ast.Expr) bool {
 	switch n := node.(type) {
 	case *ast.Ident:
@@ -100,3 +130,158 @@ func Functions(n ast.Node) []*ast.FuncDecl {
 
 	return functions
 }
+
+// FuncSignature returns the signature and the body of a function declaration or a function literal. The last return
+// argument is false for other nodes and functions without body.
+func FuncSignature(info *types.Info, node ast.Node) (*types.Signature, *ast.BlockStmt, bool) {
+	var (
+		t    types.Type
+		body *ast.BlockStmt
+	)
+	switch n := node.(type) {
+	case *ast.FuncDecl:
+		if obj, ok := info.Defs[n.Name]; ok && obj != nil {
+			t = obj.Type()
+		}
+		body = n.Body
+	case *ast.FuncLit:
+		t = info.TypeOf(n)
+		body = n.Body
+	default:
+		return nil, nil, false
+	}
+
+	sig, ok := t.(*types.Signature)
+	if !ok || body == nil {
+		return nil, nil, false
+	}
+	return sig, body, true
+}
+
+// ReturnStatements returns all return statements of a function body. Return statements of nested function literals are
+// ignored.
+func ReturnStatements(body *ast.BlockStmt) []*ast.ReturnStmt {
+	var returns []*ast.ReturnStmt
+
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ReturnStmt:
+			returns = append(returns, n)
+		}
+		return true
+	})
+
+	return returns
+}
+
+// HasLastUse reports whether the given nodes contain all uses of a local variable or an imported package. Removing such
+// nodes leads to a "declared and not used" compilation error. Variables declared inside the nodes, including implicit
+// variables of type switch clauses, are ignored, as they are removed together with their uses. Assignments to
+// variables are not uses, so only reads are counted.
+func HasLastUse(info *types.Info, nodes ...ast.Node) bool {
+	declaredInside := func(obj types.Object) bool {
+		for _, node := range nodes {
+			if node.Pos() <= obj.Pos() && obj.Pos() < node.End() || info.Implicits[node] == obj {
+				return true
+			}
+		}
+		return false
+	}
+	assigned := assignedIdents(info)
+
+	uses := make(map[types.Object]int)
+	for _, node := range nodes {
+		ast.Inspect(node, func(n ast.Node) bool {
+			ident, ok := n.(*ast.Ident)
+			if !ok || assigned[ident] {
+				return true
+			}
+			switch obj := info.Uses[ident].(type) {
+			case *types.Var:
+				if obj.Kind() == types.LocalVar && !declaredInside(obj) {
+					uses[obj]++
+				}
+			case *types.PkgName:
+				uses[obj]++
+			}
+			return true
+		})
+	}
+	if len(uses) == 0 {
+		return false
+	}
+
+	for ident, obj := range info.Uses {
+		if _, ok := uses[obj]; ok && !assigned[ident] {
+			uses[obj]--
+		}
+	}
+	for _, n := range uses {
+		if n >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// assignedIdents returns the identifiers of variables which are assigned but not read, i.e. plain identifiers on the
+// left side of assignments, short variable declarations and range clauses. Such identifiers are recorded as uses by
+// the type checker, but do not count as uses for the "declared and not used" check. The files are taken from the file
+// versions of the info, which are recorded by the package loader. The result of the last info is cached, as mutators
+// check many nodes of the same package.
+func assignedIdents(info *types.Info) map[*ast.Ident]bool {
+	assignedCache.Lock()
+	defer assignedCache.Unlock()
+	if assignedCache.info == info {
+		return assignedCache.assigned
+	}
+
+	assigned := make(map[*ast.Ident]bool)
+	add := func(exprs ...ast.Expr) {
+		for _, expr := range exprs {
+			if ident, ok := ast.Unparen(expr).(*ast.Ident); ok {
+				assigned[ident] = true
+			}
+		}
+	}
+	for file := range info.FileVersions {
+		ast.Inspect(file, func(node ast.Node) bool {
+			switch n := node.(type) {
+			case *ast.AssignStmt:
+				if n.Tok == token.ASSIGN || n.Tok == token.DEFINE {
+					add(n.Lhs...)
+				}
+			case *ast.RangeStmt:
+				if n.Tok == token.ASSIGN {
+					add(n.Key, n.Value)
+				}
+			}
+			return true
+		})
+	}
+
+	assignedCache.info, assignedCache.assigned = info, assigned
+	return assigned
+}
+
+var assignedCache struct {
+	sync.Mutex
+	info     *types.Info
+	assigned map[*ast.Ident]bool
+}
+
+// StatementList returns a pointer to the statement list of a block, a case clause or a communication clause, so
+// statements can be replaced in place. Nil is returned for other nodes.
+func StatementList(node ast.Node) *[]ast.Stmt {
+	switch n := node.(type) {
+	case *ast.BlockStmt:
+		return &n.List
+	case *ast.CaseClause:
+		return &n.Body
+	case *ast.CommClause:
+		return &n.Body
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package astutil
+
+import (
+	"go/ast"
+)
+
+// ChildExpressions returns pointers to all expressions which are direct children of the node, so the expressions can
+// be replaced in place. Type expressions, e.g. of declarations and composite literals, and names of fields and
+// selectors are not returned, as they cannot be replaced by values. Calls of expression, go and defer statements are
+// not returned either. Arguments of conversions and of builtins such as make can be types, it is up to the caller to
+// check the expressions.
+func ChildExpressions(node ast.Node) []*ast.Expr {
+	var refs []*ast.Expr
+	add := func(exprs ...*ast.Expr) {
+		for _, e := range exprs {
+			if *e != nil {
+				refs = append(refs, e)
+			}
+		}
+	}
+	addList := func(list []ast.Expr) {
+		for i := range list {
+			add(&list[i])
+		}
+	}
+
+	switch n := node.(type) {
+	// Expressions.
+	case *ast.BinaryExpr:
+		add(&n.X, &n.Y)
+	case *ast.UnaryExpr:
+		add(&n.X)
+	case *ast.ParenExpr:
+		add(&n.X)
+	case *ast.CallExpr:
+		addList(n.Args)
+	case *ast.IndexExpr:
+		add(&n.X, &n.Index)
+	case *ast.SliceExpr:
+		add(&n.X, &n.Low, &n.High, &n.Max)
+	case *ast.StarExpr:
+		add(&n.X)
+	case *ast.KeyValueExpr:
+		add(&n.Value)
+	case *ast.CompositeLit:
+		for i, elt := range n.Elts {
+			if _, ok := elt.(*ast.KeyValueExpr); !ok {
+				add(&n.Elts[i])
+			}
+		}
+	case *ast.SelectorExpr:
+		add(&n.X)
+	case *ast.TypeAssertExpr:
+		add(&n.X)
+
+	// Statements.
+	case *ast.SendStmt:
+		add(&n.Chan, &n.Value)
+	case *ast.AssignStmt:
+		addList(n.Rhs)
+	case *ast.ReturnStmt:
+		addList(n.Results)
+	case *ast.IfStmt:
+		add(&n.Cond)
+	case *ast.CaseClause:
+		addList(n.List)
+	case *ast.SwitchStmt:
+		add(&n.Tag)
+	case *ast.ForStmt:
+		add(&n.Cond)
+	case *ast.RangeStmt:
+		add(&n.X)
+
+	// Declarations.
+	case *ast.ValueSpec:
+		addList(n.Values)
+	}
+
+	return refs
+}
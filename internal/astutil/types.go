@@ -0,0 +1,264 @@
+package astutil
+
+import (
+	"bytes"
+	"go/ast"
+	"go/constant"
+	"go/printer"
+	"go/token"
+	"go/types"
+	"strconv"
+)
+
+// CreateZeroValue creates an expression of the zero value of the given type, which is valid at the given position of
+// the package. The second return argument is false if the zero value cannot be expressed at this position, e.g. if the
+// type refers to a package which is not imported by the file.
+func CreateZeroValue(pkg *types.Package, pos token.Pos, t types.Type) (ast.Expr, bool) {
+	var expr ast.Expr
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case u.Info()&types.IsBoolean != 0:
+			expr = ast.NewIdent("false")
+		case u.Info()&types.IsNumeric != 0:
+			expr = &ast.BasicLit{Kind: token.INT, Value: "0"}
+		case u.Info()&types.IsString != 0:
+			expr = &ast.BasicLit{Kind: token.STRING, Value: `""`}
+		case u.Kind() == types.UnsafePointer:
+			expr = ast.NewIdent("nil")
+		default:
+			return nil, false
+		}
+	case *types.Pointer, *types.Slice, *types.Map, *types.Chan, *types.Signature, *types.Interface:
+		if _, ok := t.(*types.TypeParam); ok {
+			return createNewZeroValue(pkg, pos, t)
+		}
+		expr = ast.NewIdent("nil")
+	case *types.Struct, *types.Array:
+		typ, ok := CreateTypeExpr(pkg, pos, t)
+		if !ok {
+			return nil, false
+		}
+		expr = &ast.CompositeLit{Type: typ}
+	default:
+		return nil, false
+	}
+
+	if !Compiles(pkg, pos, expr, t) {
+		return nil, false
+	}
+	return expr, true
+}
+
+// createNewZeroValue creates a zero value of the form *new(T), which is the only way to express the zero value of a
+// type parameter.
+func createNewZeroValue(pkg *types.Package, pos token.Pos, t types.Type) (ast.Expr, bool) {
+	typ, ok := CreateTypeExpr(pkg, pos, t)
+	if !ok {
+		return nil, false
+	}
+	expr := &ast.StarExpr{X: &ast.CallExpr{Fun: ast.NewIdent("new"), Args: []ast.Expr{typ}}}
+	if !Compiles(pkg, pos, expr, t) {
+		return nil, false
+	}
+	return expr, true
+}
+
+// CreateTypeExpr creates an expression of the given type, which is valid at the given position of the package.
+// Types of other packages are qualified with the names they are imported with by the file. The second return argument
+// is false if the type cannot be expressed, e.g. anonymous structs and interfaces or types of packages which are not
+// imported.
+func CreateTypeExpr(pkg *types.Package, pos token.Pos, t types.Type) (ast.Expr, bool) {
+	switch t := t.(type) {
+	case *types.Basic:
+		if t.Kind() == types.UnsafePointer || t.Info()&types.IsUntyped != 0 {
+			return nil, false
+		}
+		return ast.NewIdent(t.Name()), true
+	case *types.Alias:
+		return createNamedTypeExpr(pkg, pos, t.Obj(), t.TypeArgs())
+	case *types.Named:
+		return createNamedTypeExpr(pkg, pos, t.Obj(), t.TypeArgs())
+	case *types.TypeParam:
+		return ast.NewIdent(t.Obj().Name()), true
+	case *types.Pointer:
+		elem, ok := CreateTypeExpr(pkg, pos, t.Elem())
+		if !ok {
+			return nil, false
+		}
+		return &ast.StarExpr{X: elem}, true
+	case *types.Slice:
+		elem, ok := CreateTypeExpr(pkg, pos, t.Elem())
+		if !ok {
+			return nil, false
+		}
+		return &ast.ArrayType{Elt: elem}, true
+	case *types.Array:
+		elem, ok := CreateTypeExpr(pkg, pos, t.Elem())
+		if !ok {
+			return nil, false
+		}
+		return &ast.ArrayType{
+			Len: &ast.BasicLit{Kind: token.INT, Value: strconv.FormatInt(t.Len(), 10)},
+			Elt: elem,
+		}, true
+	case *types.Map:
+		key, ok := CreateTypeExpr(pkg, pos, t.Key())
+		if !ok {
+			return nil, false
+		}
+		value, ok := CreateTypeExpr(pkg, pos, t.Elem())
+		if !ok {
+			return nil, false
+		}
+		return &ast.MapType{Key: key, Value: value}, true
+	case *types.Chan:
+		elem, ok := CreateTypeExpr(pkg, pos, t.Elem())
+		if !ok {
+			return nil, false
+		}
+		dir := ast.SEND | ast.RECV
+		switch t.Dir() {
+		case types.SendOnly:
+			dir = ast.SEND
+		case types.RecvOnly:
+			dir = ast.RECV
+		}
+		return &ast.ChanType{Dir: dir, Value: elem}, true
+	}
+	return nil, false
+}
+
+func createNamedTypeExpr(pkg *types.Package, pos token.Pos, obj *types.TypeName, targs *types.TypeList) (ast.Expr, bool) {
+	var expr ast.Expr
+	switch {
+	case obj.Pkg() == nil || obj.Pkg() == pkg:
+		// Universe scope or local types. Local types declared in other functions are not visible, it is checked
+		// by the caller.
+		expr = ast.NewIdent(obj.Name())
+	case !obj.Exported():
+		return nil, false
+	default:
+		name, ok := ImportName(pkg, pos, obj.Pkg())
+		if !ok {
+			return nil, false
+		}
+		expr = &ast.SelectorExpr{X: ast.NewIdent(name), Sel: ast.NewIdent(obj.Name())}
+	}
+
+	if targs.Len() == 0 {
+		return expr, true
+	}
+	indices := make([]ast.Expr, targs.Len())
+	for i := range targs.Len() {
+		arg, ok := CreateTypeExpr(pkg, pos, targs.At(i))
+		if !ok {
+			return nil, false
+		}
+		indices[i] = arg
+	}
+	return &ast.IndexListExpr{X: expr, Indices: indices}, true
+}
+
+// ImportName returns the name the imported package is available with at the given position of the package.
+func ImportName(pkg *types.Package, pos token.Pos, imported *types.Package) (string, bool) {
+	return importName(pkg, pos, func(p *types.Package) bool { return p == imported })
+}
+
+// ImportNameByPath returns the name the package with the given import path is available with at the given position
+// of the package.
+func ImportNameByPath(pkg *types.Package, pos token.Pos, path string) (string, bool) {
+	return importName(pkg, pos, func(p *types.Package) bool { return p.Path() == path })
+}
+
+func importName(pkg *types.Package, pos token.Pos, match func(*types.Package) bool) (string, bool) {
+	scope := fileScope(pkg, pos)
+	if scope == nil {
+		return "", false
+	}
+	for _, name := range scope.Names() {
+		if pn, ok := scope.Lookup(name).(*types.PkgName); ok && match(pn.Imported()) {
+			return pn.Name(), true
+		}
+	}
+	return "", false
+}
+
+// fileScope returns the scope of the file containing the given position.
+func fileScope(pkg *types.Package, pos token.Pos) *types.Scope {
+	if pkg == nil {
+		return nil
+	}
+	for i := range pkg.Scope().NumChildren() {
+		if s := pkg.Scope().Child(i); s.Contains(pos) {
+			return s
+		}
+	}
+	return nil
+}
+
+// Compiles reports whether the expression is valid at the given position of the package and is assignable to the
+// given type. A nil type allows any type.
+func Compiles(pkg *types.Package, pos token.Pos, expr ast.Expr, t types.Type) bool {
+	if pkg == nil {
+		return false
+	}
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, token.NewFileSet(), expr); err != nil {
+		return false
+	}
+	tv, err := types.Eval(token.NewFileSet(), pkg, pos, buf.String())
+	if err != nil {
+		return false
+	}
+	if t == nil {
+		return true
+	}
+	return tv.Type != nil && types.AssignableTo(tv.Type, t)
+}
+
+var errorInterface = types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+
+// ImplementsError reports whether the type implements the error interface.
+func ImplementsError(t types.Type) bool {
+	return t != nil && types.Implements(t, errorInterface)
+}
+
+// IsErrorType reports whether the type is exactly the predeclared error type.
+func IsErrorType(t types.Type) bool {
+	return t != nil && types.Identical(t, types.Universe.Lookup("error").Type())
+}
+
+// IsUniverse reports whether the expression is an identifier of the predeclared object with the given name, e.g.
+// nil, true or len. Shadowed identifiers are not reported.
+func IsUniverse(info *types.Info, expr ast.Expr, name string) bool {
+	ident, ok := ast.Unparen(expr).(*ast.Ident)
+	if !ok || ident.Name != name {
+		return false
+	}
+	return info.Uses[ident] == types.Universe.Lookup(name)
+}
+
+// IsZeroValue reports whether the expression is a zero value literal: nil, false, 0, "" or an empty composite literal.
+func IsZeroValue(info *types.Info, expr ast.Expr) bool {
+	expr = ast.Unparen(expr)
+	if IsUniverse(info, expr, "nil") {
+		return true
+	}
+	if lit, ok := expr.(*ast.CompositeLit); ok {
+		return len(lit.Elts) == 0
+	}
+	tv, ok := info.Types[expr]
+	if !ok || tv.Value == nil {
+		return false
+	}
+	switch tv.Value.Kind() {
+	case constant.Bool:
+		return !constant.BoolVal(tv.Value)
+	case constant.String:
+		return constant.StringVal(tv.Value) == ""
+	case constant.Int, constant.Float, constant.Complex:
+		return constant.Sign(tv.Value) == 0
+	}
+	return false
+}
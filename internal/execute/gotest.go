@@ -27,9 +27,11 @@ type replaceData struct {
 }
 
 type GoTestOptions struct {
-	Changed       string
-	Original      string
-	PackagePath   string
+	Changed     string
+	Original    string
+	PackagePath string
+	// ModuleDir is the root directory of the module of the package. Tests are executed from it.
+	ModuleDir     string
 	Debug         bool
 	SilentMode    bool
 	TestRecursive bool
@@ -54,7 +56,7 @@ func GoTest(ctx context.Context, mutant *report.Mutant, opts GoTestOptions) erro
 		return fmt.Errorf("write overlay file: %w", err)
 	}
 
-	err = runGoTest(ctx, opts.PackagePath, overlayFile, opts.TestRecursive)
+	err = runGoTest(ctx, opts.ModuleDir, opts.PackagePath, overlayFile, opts.TestRecursive)
 
 	mutant.Diff = string(diffStr)
 
@@ -83,7 +85,7 @@ func GoTest(ctx context.Context, mutant *report.Mutant, opts GoTestOptions) erro
 }
 
 // GoTest executes default go test command and returns is mutation was "killed", i.e. tests failed.
-func runGoTest(ctx context.Context, pkgName, overlayFile string, recursive bool) error {
+func runGoTest(ctx context.Context, dir, pkgName, overlayFile string, recursive bool) error {
 	if recursive {
 		pkgName += "/..."
 	}
@@ -94,6 +96,7 @@ func runGoTest(ctx context.Context, pkgName, overlayFile string, recursive bool)
 		"-overlay", overlayFile,
 		pkgName,
 	)
+	cmd.Dir = dir
 	cmd.Env = os.Environ() // Is is necessary?
 
 	output, err := cmd.CombinedOutput()
@@ -0,0 +1,103 @@
+// Package ignore implements the file of triaged mutants.
+package ignore
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Status is a triage decision for a mutant.
+type Status string
+
+const (
+	// StatusEquivalent means the mutant is equivalent to the original code and must not be executed.
+	StatusEquivalent Status = "equivalent"
+	// StatusPostponed means the mutant should be looked at later. It is still executed.
+	StatusPostponed Status = "postponed"
+)
+
+// Entry is a triaged mutant.
+type Entry struct {
+	ID      string    `yaml:"id"`
+	Status  Status    `yaml:"status"`
+	Reason  string    `yaml:"reason,omitempty"`
+	Author  string    `yaml:"author,omitempty"`
+	Date    time.Time `yaml:"date"`
+	Mutator string    `yaml:"mutator,omitempty"`
+	File    string    `yaml:"file,omitempty"`
+	Line    int64     `yaml:"line,omitempty"`
+}
+
+// File is a list of triaged mutants.
+type File struct {
+	Mutants []Entry `yaml:"mutants"`
+}
+
+// Load reads the file with the given name. A missing file is considered empty.
+func Load(name string) (*File, error) {
+	data, err := os.ReadFile(name)
+	if errors.Is(err, os.ErrNotExist) {
+		return new(File), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read file: %w", err)
+	}
+
+	var f File
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("unmarshal %q: %w", name, err)
+	}
+	for _, e := range f.Mutants {
+		if e.ID == "" {
+			return nil, fmt.Errorf("entry without mutant ID in %q", name)
+		}
+	}
+	return &f, nil
+}
+
+// Save writes the file with the given name.
+func (f *File) Save(name string) error {
+	data, err := yaml.Marshal(f)
+	if err != nil {
+		return fmt.Errorf("marshal: %w", err)
+	}
+	if err := os.WriteFile(name, data, 0644); err != nil {
+		return fmt.Errorf("write file: %w", err)
+	}
+	return nil
+}
+
+// Lookup returns the entry of the mutant with the given ID.
+func (f *File) Lookup(id string) (Entry, bool) {
+	i := slices.IndexFunc(f.Mutants, func(e Entry) bool { return e.ID == id })
+	if i < 0 {
+		return Entry{}, false
+	}
+	return f.Mutants[i], true
+}
+
+// Set adds the entry or replaces the existing entry of the same mutant.
+func (f *File) Set(e Entry) {
+	i := slices.IndexFunc(f.Mutants, func(x Entry) bool { return x.ID == e.ID })
+	if i < 0 {
+		f.Mutants = append(f.Mutants, e)
+		return
+	}
+	f.Mutants[i] = e
+}
+
+// Equivalent returns IDs of mutants marked as equivalent.
+func (f *File) Equivalent() map[string]struct{} {
+	ids := make(map[string]struct{})
+	for _, e := range f.Mutants {
+		if e.Status == StatusEquivalent {
+			ids[e.ID] = struct{}{}
+		}
+	}
+	return ids
+}
@@ -0,0 +1,51 @@
+package ignore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFile(t *testing.T) {
+	t.Parallel()
+
+	name := filepath.Join(t.TempDir(), "ignore.yml")
+
+	f, err := Load(name)
+	require.NoError(t, err, "missing file")
+	assert.Empty(t, f.Mutants)
+
+	date := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	f.Set(Entry{ID: "a", Status: StatusPostponed, Date: date})
+	f.Set(Entry{ID: "b", Status: StatusEquivalent, Reason: "early exit", Author: "gopher", Date: date})
+	f.Set(Entry{ID: "a", Status: StatusEquivalent, Reason: "cache", Date: date})
+	require.NoError(t, f.Save(name))
+
+	f, err = Load(name)
+	require.NoError(t, err)
+	assert.Equal(t, []Entry{
+		{ID: "a", Status: StatusEquivalent, Reason: "cache", Date: date},
+		{ID: "b", Status: StatusEquivalent, Reason: "early exit", Author: "gopher", Date: date},
+	}, f.Mutants)
+	assert.Equal(t, map[string]struct{}{"a": {}, "b": {}}, f.Equivalent())
+
+	e, ok := f.Lookup("b")
+	assert.True(t, ok)
+	assert.Equal(t, "early exit", e.Reason)
+	_, ok = f.Lookup("c")
+	assert.False(t, ok)
+}
+
+func TestLoadWithoutID(t *testing.T) {
+	t.Parallel()
+
+	name := filepath.Join(t.TempDir(), "ignore.yml")
+	require.NoError(t, os.WriteFile(name, []byte("mutants:\n  - status: equivalent\n"), 0644))
+
+	_, err := Load(name)
+	assert.ErrorContains(t, err, "entry without mutant ID")
+}
@@ -4,10 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"iter"
 	"log"
 	"log/slog"
 	"os"
+	"path"
+	"path/filepath"
 	"regexp"
 	"slices"
 	"strings"
@@ -22,20 +27,20 @@ type Options struct {
 	SkipFileWithoutTest  bool
 	SkipFileWithBuildTag bool
 	GitMainBranch        string
-	ExcludeDirs          []string
+	// Exclude contains glob patterns of files to skip. See [isExcluded] for matching rules.
+	Exclude []string
+	// IncludeGenerated disables skipping of generated files, i.e. files with the standard
+	// "// Code generated ... DO NOT EDIT." header.
+	IncludeGenerated bool
 }
 
 func FilesOfArgs(ctx context.Context, args []string, opts Options) ([]string, error) {
 	if len(args) == 0 {
 		args = []string{"."}
 	}
-	pkgs, err := packages.Load(&packages.Config{
-		Context: ctx,
-		Mode:    packages.NeedFiles,
-		Tests:   false,
-	}, args...)
+	pkgs, err := loadPackages(ctx, args)
 	if err != nil {
-		return nil, fmt.Errorf("load packages: %w", err)
+		return nil, err
 	}
 
 	var gitChangedFiles []string
@@ -47,24 +52,37 @@ func FilesOfArgs(ctx context.Context, args []string, opts Options) ([]string, er
 		}
 	}
 
-	var files []string
-	for _, p := range pkgs {
-		iter := skipExcludedDirs(
-			removeDuplicates(slices.Values(p.GoFiles)),
-			opts.ExcludeDirs,
-		)
-		if opts.GitMainBranch != "" {
-			iter = skipUnchangedFiles(iter, gitChangedFiles)
+	iter := skipExcludedFiles(
+		removeDuplicates(packageFiles(pkgs)),
+		opts.Exclude,
+	)
+	if !opts.IncludeGenerated {
+		iter = skipGeneratedFiles(iter)
+	}
+	if opts.GitMainBranch != "" {
+		iter = skipUnchangedFiles(iter, gitChangedFiles)
+	}
+	if opts.SkipFileWithoutTest || opts.SkipFileWithBuildTag {
+		iter = skipFilesWithoutTests(iter)
+		if opts.SkipFileWithBuildTag {
+			iter = skipFilesWithBuildTag(iter)
 		}
-		if opts.SkipFileWithoutTest || opts.SkipFileWithBuildTag {
-			iter = skipFilesWithoutTests(iter)
-			if opts.SkipFileWithBuildTag {
-				iter = skipFilesWithBuildTag(iter)
+	}
+	return slices.Collect(iter), nil
+}
+
+// packageFiles returns Go files of all packages. Packages are loaded in multiple module contexts, so the same file
+// may be returned several times.
+func packageFiles(pkgs []*packages.Package) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for _, p := range pkgs {
+			for _, filename := range p.GoFiles {
+				if !yield(filename) {
+					return
+				}
 			}
 		}
-		files = append(files, slices.Collect(iter)...)
 	}
-	return files, nil
 }
 
 func removeDuplicates(files iter.Seq[string]) iter.Seq[string] {
@@ -82,15 +100,44 @@ func removeDuplicates(files iter.Seq[string]) iter.Seq[string] {
 	}
 }
 
-func skipExcludedDirs(files iter.Seq[string], excludedDirs []string) iter.Seq[string] {
+func skipExcludedFiles(files iter.Seq[string], patterns []string) iter.Seq[string] {
 	return func(yield func(string) bool) {
-	OUTER_LOOP:
 		for filename := range files {
-			for _, dir := range excludedDirs {
-				// TODO: Replace with [strings.HasPrefix] in case of errors.
-				if strings.Contains(filename, dir) {
-					continue OUTER_LOOP
-				}
+			if isExcluded(filename, patterns) {
+				continue
+			}
+			if !yield(filename) {
+				return
+			}
+		}
+	}
+}
+
+// isExcluded reports whether any of the glob patterns matches the filename. A pattern is matched with [path.Match]
+// against every sequence of consecutive path elements with the same length as the pattern, joined by slashes, so
+// `mocks` excludes every file under a `mocks` directory, `*_string.go` excludes files by name and `internal/gen/*.go`
+// excludes files of a particular directory. Patterns use slashes as separators on every platform, separators of the
+// platform are converted to slashes, so backslashes cannot escape special characters on Windows.
+func isExcluded(filename string, patterns []string) bool {
+	elems := strings.Split(filepath.ToSlash(filepath.Clean(filename)), "/")
+	for _, pattern := range patterns {
+		pattern = strings.Trim(filepath.ToSlash(filepath.Clean(pattern)), "/")
+		n := strings.Count(pattern, "/") + 1
+		for i := 0; i+n <= len(elems); i++ {
+			if ok, _ := path.Match(pattern, strings.Join(elems[i:i+n], "/")); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// skipGeneratedFiles skips files recognized as generated by [ast.IsGenerated].
+func skipGeneratedFiles(files iter.Seq[string]) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for filename := range files {
+			if isGenerated(filename) {
+				continue
 			}
 			if !yield(filename) {
 				return
@@ -99,6 +146,15 @@ func skipExcludedDirs(files iter.Seq[string], excludedDirs []string) iter.Seq[st
 	}
 }
 
+func isGenerated(filename string) bool {
+	src, err := parser.ParseFile(token.NewFileSet(), filename, nil, parser.PackageClauseOnly|parser.ParseComments)
+	if err != nil {
+		slog.Warn("check generated file", slog.String("file", filename), slog.Any("error", err))
+		return false
+	}
+	return ast.IsGenerated(src)
+}
+
 func skipFilesWithoutTests(files iter.Seq[string]) iter.Seq[string] {
 	const extLen = len(".go")
 	return func(yield func(string) bool) {
@@ -118,17 +174,11 @@ func skipFilesWithoutTests(files iter.Seq[string]) iter.Seq[string] {
 	}
 }
 
+// skipUnchangedFiles skips files which are not in the list of changed files. Both lists must contain absolute paths.
 func skipUnchangedFiles(files iter.Seq[string], changedFiles []string) iter.Seq[string] {
 	return func(yield func(string) bool) {
 		for filename := range files {
-			changed := false
-			for _, changedFile := range changedFiles {
-				if strings.HasSuffix(filename, changedFile) {
-					changed = true
-					break
-				}
-			}
-			if !changed {
+			if !slices.Contains(changedFiles, filepath.Clean(filename)) {
 				continue
 			}
 			if !yield(filename) {
@@ -172,11 +222,18 @@ func regexpSearchInFile(file string, re *regexp.Regexp) bool {
 	return re.MatchString(string(contents))
 }
 
+// getChangedFilesFromGit returns absolute paths of files changed against the main branch. The repository is detected
+// from the working directory, which may be any directory of the work tree, e.g. a nested module.
 func getChangedFilesFromGit(mainBranch string) ([]string, error) {
-	repo, err := git.PlainOpen("./")
+	repo, err := git.PlainOpenWithOptions(".", &git.PlainOpenOptions{DetectDotGit: true})
 	if err != nil {
 		return nil, fmt.Errorf("open git repository: %w", err)
 	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return nil, fmt.Errorf("get worktree: %w", err)
+	}
+	root := worktree.Filesystem().Root()
 
 	currRef, err := repo.Head()
 	if err != nil {
@@ -213,7 +270,7 @@ func getChangedFilesFromGit(mainBranch string) ([]string, error) {
 	var changedFiles []string
 	for _, change := range changes {
 		if change.To.Name != "" {
-			changedFiles = append(changedFiles, change.To.Name)
+			changedFiles = append(changedFiles, filepath.Join(root, filepath.FromSlash(change.To.Name)))
 		}
 	}
 
@@ -23,6 +23,7 @@ func TestFilesOfArgs(t *testing.T) {
 			args: []string{},
 			expect: []string{
 				"go-mutesting/internal/importing/filepath.go",
+				"go-mutesting/internal/importing/module.go",
 				"go-mutesting/internal/importing/parse.go",
 			},
 		},
@@ -233,10 +234,77 @@ func TestFilesWithExcludedDirs(t *testing.T) {
 			},
 			config: []string{"filepathfixtures/secondfixturespackage"},
 		},
+		{
+			name: "files by name pattern",
+			args: []string{"./filepathfixtures/..."},
+			expect: []string{
+				"go-mutesting/internal/importing/filepathfixtures/first.go",
+				"go-mutesting/internal/importing/filepathfixtures/second.go",
+			},
+			config: []string{"t*.go", "fourth.go"},
+		},
+		{
+			name: "files by directory pattern",
+			args: []string{"./filepathfixtures/..."},
+			expect: []string{
+				"go-mutesting/internal/importing/filepathfixtures/secondfixturespackage/fourth.go",
+			},
+			config: []string{"filepathfixtures/*.go"},
+		},
+		{
+			name: "directories by pattern",
+			args: []string{"./filepathfixtures/..."},
+			expect: []string{
+				"go-mutesting/internal/importing/filepathfixtures/first.go",
+				"go-mutesting/internal/importing/filepathfixtures/second.go",
+				"go-mutesting/internal/importing/filepathfixtures/third.go",
+			},
+			config: []string{"*package"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, _ := FilesOfArgs(t.Context(), test.args, Options{Exclude: test.config})
+
+			assert.Equal(t, test.expect, cleanupPaths(t, got), fmt.Sprintf("With args: %#v", test.args))
+		})
+	}
+}
+
+func TestFilesWithGenerated(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name             string
+		args             []string
+		includeGenerated bool
+		expect           []string
+	}{
+		{
+			name:   "generated file",
+			args:   []string{"./filepathfixtures/generated.go"},
+			expect: []string{},
+		},
+		{
+			name:             "include generated file",
+			args:             []string{"./filepathfixtures/generated.go"},
+			includeGenerated: true,
+			expect:           []string{"go-mutesting/internal/importing/filepathfixtures/generated.go"},
+		},
+		{
+			name:             "include generated directories",
+			args:             []string{"./filepathfixtures"},
+			includeGenerated: true,
+			expect: []string{
+				"go-mutesting/internal/importing/filepathfixtures/first.go",
+				"go-mutesting/internal/importing/filepathfixtures/generated.go",
+				"go-mutesting/internal/importing/filepathfixtures/second.go",
+				"go-mutesting/internal/importing/filepathfixtures/third.go",
+			},
+		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
-			got, _ := FilesOfArgs(t.Context(), test.args, Options{ExcludeDirs: test.config})
+			got, _ := FilesOfArgs(t.Context(), test.args, Options{IncludeGenerated: test.includeGenerated})
 
 			assert.Equal(t, test.expect, cleanupPaths(t, got), fmt.Sprintf("With args: %#v", test.args))
 		})
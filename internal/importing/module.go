@@ -0,0 +1,142 @@
+package importing
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// ModuleRoot returns the directory of the nearest go.mod file containing dir. If there is no such file, an empty
+// string is returned.
+func ModuleRoot(dir string) string {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+	for {
+		if exists(filepath.Join(dir, "go.mod")) {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// Modules discovers module roots inside the given directory, including the directory itself if it is a module root.
+// Directories ignored by the go command, i.e. vendor, testdata and directories beginning with "." or "_", are skipped.
+func Modules(root string) ([]string, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("get abs root: %w", err)
+	}
+
+	var modules []string
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if path != root {
+			name := d.Name()
+			if name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") {
+				return filepath.SkipDir
+			}
+		}
+		if exists(filepath.Join(path, "go.mod")) {
+			modules = append(modules, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walk %q: %w", root, err)
+	}
+	return modules, nil
+}
+
+// loadPackages loads packages of the given patterns. Patterns which point to the file system are loaded from the root
+// of the module they belong to, and recursive patterns additionally load all nested modules. This allows to handle
+// multi-module repositories regardless of whether modules are joined with a go.work file.
+func loadPackages(ctx context.Context, args []string) ([]*packages.Package, error) {
+	patterns := make(map[string][]string) // Module root to patterns.
+	var roots []string
+	add := func(dir, pattern string) {
+		if _, ok := patterns[dir]; !ok {
+			roots = append(roots, dir)
+		}
+		patterns[dir] = append(patterns[dir], pattern)
+	}
+
+	for _, arg := range args {
+		if !isFilesystemPattern(arg) {
+			add("", arg)
+			continue
+		}
+
+		path, recursive := strings.CutSuffix(filepath.ToSlash(arg), "/...")
+		path, err := filepath.Abs(filepath.FromSlash(path))
+		if err != nil {
+			return nil, fmt.Errorf("get abs path of %q: %w", arg, err)
+		}
+
+		if !recursive {
+			dir := path
+			if !isDir(path) {
+				dir = filepath.Dir(path)
+			}
+			add(ModuleRoot(dir), path)
+			continue
+		}
+
+		add(ModuleRoot(path), path+string(filepath.Separator)+"...")
+		nested, err := Modules(path)
+		if err != nil {
+			return nil, fmt.Errorf("discover modules: %w", err)
+		}
+		for _, m := range nested {
+			if m != path {
+				add(m, m+string(filepath.Separator)+"...")
+			}
+		}
+	}
+
+	var pkgs []*packages.Package
+	for _, root := range roots {
+		p, err := packages.Load(&packages.Config{
+			Context: ctx,
+			Mode:    packages.NeedFiles,
+			Dir:     root,
+			Tests:   false,
+		}, patterns[root]...)
+		if err != nil {
+			return nil, fmt.Errorf("load packages: %w", err)
+		}
+		pkgs = append(pkgs, p...)
+	}
+	return pkgs, nil
+}
+
+// isFilesystemPattern reports whether the pattern is a file system path rather than an import path. It follows the
+// go command rules: rooted and relative paths are file system paths.
+func isFilesystemPattern(pattern string) bool {
+	return pattern == "." || pattern == ".." ||
+		filepath.IsAbs(pattern) ||
+		slices.ContainsFunc([]string{"./", "../", `.\`, `..\`}, func(prefix string) bool {
+			return strings.HasPrefix(pattern, prefix)
+		})
+}
+
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
@@ -0,0 +1,83 @@
+package importing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModuleRoot(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name   string
+		dir    string
+		expect string
+	}{
+		{
+			name:   "main module",
+			dir:    "./filepathfixtures",
+			expect: "go-mutesting",
+		},
+		{
+			name:   "nested module",
+			dir:    "./modulefixtures",
+			expect: "go-mutesting/internal/importing/modulefixtures",
+		},
+		{
+			name:   "nested module of nested module",
+			dir:    "./modulefixtures/nested",
+			expect: "go-mutesting/internal/importing/modulefixtures/nested",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, []string{test.expect}, cleanupPaths(t, []string{ModuleRoot(test.dir)}))
+		})
+	}
+}
+
+func TestModules(t *testing.T) {
+	t.Parallel()
+	got, err := Modules("./modulefixtures")
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"go-mutesting/internal/importing/modulefixtures",
+		"go-mutesting/internal/importing/modulefixtures/nested",
+	}, cleanupPaths(t, got))
+}
+
+func TestFilesOfArgsMultiModule(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name   string
+		args   []string
+		expect []string
+	}{
+		{
+			name:   "nested module",
+			args:   []string{"./modulefixtures"},
+			expect: []string{"go-mutesting/internal/importing/modulefixtures/first.go"},
+		},
+		{
+			name:   "relative nested module file",
+			args:   []string{"./modulefixtures/nested/second.go"},
+			expect: []string{"go-mutesting/internal/importing/modulefixtures/nested/second.go"},
+		},
+		{
+			name: "nested modules recursive",
+			args: []string{"./modulefixtures/..."},
+			expect: []string{
+				"go-mutesting/internal/importing/modulefixtures/first.go",
+				"go-mutesting/internal/importing/modulefixtures/nested/second.go",
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, _ := FilesOfArgs(t.Context(), test.args, Options{})
+
+			assert.Equal(t, test.expect, cleanupPaths(t, got))
+		})
+	}
+}
@@ -0,0 +1,3 @@
+module example.com/modulefixtures/nested
+
+go 1.26.2
@@ -50,10 +50,12 @@ func parseFile(ctx context.Context, filename string) (*packages.Package, *ast.Fi
 		return nil, nil, fmt.Errorf("get abs filename: %w", err)
 	}
 
+	// Load the package from its own directory, so the go command picks up the module (or workspace) it belongs to.
 	pkgs, err := packages.Load(&packages.Config{
 		Context: ctx,
-		Mode:    packages.LoadSyntax,
-	}, filepath.Dir(filenameAbs))
+		Mode:    packages.LoadSyntax | packages.NeedModule,
+		Dir:     filepath.Dir(filenameAbs),
+	}, ".")
 	if err != nil {
 		return nil, nil, fmt.Errorf("load package: %w", err)
 	}
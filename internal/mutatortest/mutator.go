@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"fmt"
 	"go/ast"
+	"go/parser"
 	"go/printer"
+	"go/types"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -63,6 +66,8 @@ func Run(t *testing.T, mut mutator.Mutator, testFile string, expectedMutationCou
 				assert.Nil(t, err)
 			}
 
+			assert.NoError(t, typeCheck(pkg, src, buf.Bytes()), fmt.Sprintf("For change file %q", changedFilename))
+
 			mutationsCount++
 		},
 		func() {
@@ -90,3 +95,38 @@ func countWalk(pkg *packages.Package, node ast.Node, m mutator.Mutator, skippedL
 	}
 	return count
 }
+
+// typeCheck type-checks the package of the file with the mutated source of the file, so mutants which do not compile
+// are reported. Imports are resolved with the already loaded imports of the package.
+func typeCheck(pkg *packages.Package, src *ast.File, mutated []byte) error {
+	file, err := parser.ParseFile(pkg.Fset, pkg.Fset.Position(src.Pos()).Filename, mutated, parser.SkipObjectResolution)
+	if err != nil {
+		return err
+	}
+	files := slices.Clone(pkg.Syntax)
+	files[slices.Index(files, src)] = file
+
+	imports := make(map[string]*types.Package)
+	for _, p := range pkg.Types.Imports() {
+		imports[p.Path()] = p
+	}
+	conf := types.Config{
+		Importer: importerFunc(func(path string) (*types.Package, error) {
+			if p, ok := imports[path]; ok {
+				return p, nil
+			}
+			return nil, fmt.Errorf("package %q is not imported", path)
+		}),
+	}
+	if pkg.Module != nil && pkg.Module.GoVersion != "" {
+		conf.GoVersion = "go" + pkg.Module.GoVersion
+	}
+	_, err = conf.Check(pkg.PkgPath, pkg.Fset, files, nil)
+	return err
+}
+
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) {
+	return f(path)
+}
@@ -32,10 +32,12 @@ type Stats struct {
 	MutationCodeCoverage int64   `json:"mutationCodeCoverage"`
 	CoveredCodeMsi       float64 `json:"coveredCodeMsi"`
 	DuplicatedCount      int64   `json:"-"`
+	IgnoredCount         int64   `json:"-"`
 }
 
 // Mutant report by mutant for one mutation on one file
 type Mutant struct {
+	ID            string  `json:"id"`
 	Mutator       Mutator `json:"mutator"`
 	Diff          string  `json:"diff"`
 	ProcessOutput string  `json:"processOutput,omitempty"`
@@ -74,11 +76,12 @@ func (r *Report) TotalCount() int64 {
 
 // String implements [fmt.Stringer] interface.
 func (r *Report) String() string {
-	return fmt.Sprintf("The mutation score is %f (%d passed, %d failed, %d duplicated, %d skipped, total is %d)",
+	return fmt.Sprintf("The mutation score is %f (%d passed, %d failed, %d duplicated, %d ignored, %d skipped, total is %d)",
 		r.Stats.Msi,
 		r.Stats.KilledCount,
 		r.Stats.EscapedCount,
 		r.Stats.DuplicatedCount,
+		r.Stats.IgnoredCount,
 		r.Stats.SkippedCount,
 		r.Stats.TotalMutantsCount,
 	)
@@ -100,3 +103,18 @@ func (r *Report) WriteToFile() error {
 
 	return nil
 }
+
+// ReadFile reads a report file written by [Report.WriteToFile].
+func ReadFile(name string) (*Report, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("read file: %w", err)
+	}
+
+	var r Report
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("decode json: %w", err)
+	}
+
+	return &r, nil
+}
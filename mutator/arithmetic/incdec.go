@@ -0,0 +1,92 @@
+package arithmetic
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("arithmetic/incdec", MutatorArithmeticIncDec)
+}
+
+var incDecMutations = map[token.Token]token.Token{
+	token.INC: token.DEC,
+	token.DEC: token.INC,
+}
+
+// MutatorArithmeticIncDec implements a mutator to swap increment and decrement statements.
+func MutatorArithmeticIncDec(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	var mutations []mutator.Mutation
+
+	for _, ref := range incDecStatements(info, node) {
+		n := (*ref).(*ast.IncDecStmt)
+		original := n.Tok
+		mutated := incDecMutations[n.Tok]
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				n.Tok = mutated
+			},
+			Reset: func() {
+				n.Tok = original
+			},
+		})
+	}
+
+	return mutations
+}
+
+// incDecStatements returns pointers to increment and decrement statements of the statement list or the post statement
+// of the node. Post statements of loops which change a variable of the loop condition are skipped, as such loops would
+// never terminate.
+func incDecStatements(info *types.Info, node ast.Node) []*ast.Stmt {
+	var refs []*ast.Stmt
+
+	if n, ok := node.(*ast.ForStmt); ok {
+		if s, ok := n.Post.(*ast.IncDecStmt); ok && !sharesObjects(info, s.X, n.Cond) {
+			refs = append(refs, &n.Post)
+		}
+		return refs
+	}
+
+	list := astutil.StatementList(node)
+	if list == nil {
+		return nil
+	}
+	for i := range *list {
+		if _, ok := (*list)[i].(*ast.IncDecStmt); ok {
+			refs = append(refs, &(*list)[i])
+		}
+	}
+
+	return refs
+}
+
+// sharesObjects reports whether both nodes use a common object.
+func sharesObjects(info *types.Info, a ast.Node, b ast.Node) bool {
+	if a == nil || b == nil {
+		return false
+	}
+
+	objects := map[types.Object]bool{}
+	ast.Inspect(a, func(node ast.Node) bool {
+		if ident, ok := node.(*ast.Ident); ok && info.Uses[ident] != nil {
+			objects[info.Uses[ident]] = true
+		}
+		return true
+	})
+
+	shared := false
+	ast.Inspect(b, func(node ast.Node) bool {
+		if ident, ok := node.(*ast.Ident); ok && objects[info.Uses[ident]] {
+			shared = true
+		}
+		return !shared
+	})
+
+	return shared
+}
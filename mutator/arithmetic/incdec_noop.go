@@ -0,0 +1,34 @@
+package arithmetic
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("arithmetic/incdec_noop", MutatorArithmeticIncDecNoop)
+}
+
+// MutatorArithmeticIncDecNoop implements a mutator to replace increment and decrement statements with noop statements.
+func MutatorArithmeticIncDecNoop(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	var mutations []mutator.Mutation
+
+	for _, ref := range incDecStatements(info, node) {
+		original := *ref
+		mutated := astutil.CreateNoopOfStatements(pkg, info, original)
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				*ref = mutated
+			},
+			Reset: func() {
+				*ref = original
+			},
+		})
+	}
+
+	return mutations
+}
@@ -0,0 +1,16 @@
+package arithmetic
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorArithmeticIncDecNoop(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorArithmeticIncDecNoop,
+		"../../testdata/arithmetic/incdec_noop.go",
+		5,
+	)
+}
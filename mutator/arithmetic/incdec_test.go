@@ -0,0 +1,16 @@
+package arithmetic
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorArithmeticIncDec(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorArithmeticIncDec,
+		"../../testdata/arithmetic/incdec.go",
+		5,
+	)
+}
@@ -0,0 +1,191 @@
+package arithmetic
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("arithmetic/unary", MutatorArithmeticUnary)
+}
+
+// MutatorArithmeticUnary implements a mutator to change unary arithmetic. The sign of numbers is swapped and bitwise
+// complements are removed. Address and dereference operators of call arguments are removed or swapped, if the mutated
+// argument is still assignable to the parameter, e.g. "json.Unmarshal(data, &v)" is replaced by
+// "json.Unmarshal(data, v)".
+func MutatorArithmeticUnary(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	// Changed values can duplicate other cases.
+	if _, ok := node.(*ast.CaseClause); ok {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	for _, ref := range astutil.ChildExpressions(node) {
+		for _, mutated := range unaryReplacements(info, node, ref) {
+			original := *ref
+			mutations = append(mutations, mutator.Mutation{
+				Change: func() {
+					*ref = mutated
+				},
+				Reset: func() {
+					*ref = original
+				},
+			})
+		}
+	}
+
+	return mutations
+}
+
+// unaryReplacements returns the replacements of the unary expression with the given parent.
+func unaryReplacements(info *types.Info, parent ast.Node, ref *ast.Expr) []ast.Expr {
+	switch n := (*ref).(type) {
+	case *ast.UnaryExpr:
+		switch n.Op {
+		case token.SUB:
+			if negatable(info, n) {
+				return []ast.Expr{&ast.UnaryExpr{Op: token.ADD, X: n.X}}
+			}
+		case token.ADD:
+			if negatable(info, n) {
+				return []ast.Expr{&ast.UnaryExpr{Op: token.SUB, X: n.X}}
+			}
+		case token.XOR:
+			return []ast.Expr{n.X}
+		case token.AND:
+			var replacements []ast.Expr
+			if t := info.TypeOf(n.X); t != nil {
+				replacements = append(replacements, n.X)
+				if _, ok := t.Underlying().(*types.Pointer); ok {
+					replacements = append(replacements, &ast.StarExpr{X: n.X})
+				}
+			}
+			return assignableReplacements(info, parent, ref, replacements)
+		}
+	case *ast.StarExpr:
+		if tv, ok := info.Types[n]; !ok || !tv.IsValue() {
+			return nil
+		}
+		replacements := []ast.Expr{n.X}
+		if ident, ok := n.X.(*ast.Ident); ok {
+			if _, ok := info.Uses[ident].(*types.Var); ok {
+				replacements = append(replacements, &ast.UnaryExpr{Op: token.AND, X: n.X})
+			}
+		}
+		return assignableReplacements(info, parent, ref, replacements)
+	}
+
+	return nil
+}
+
+// assignableReplacements returns the replacements which are assignable to the parameter of the call argument.
+func assignableReplacements(info *types.Info, parent ast.Node, ref *ast.Expr, replacements []ast.Expr) []ast.Expr {
+	param := parameterType(info, parent, ref)
+	if param == nil {
+		return nil
+	}
+
+	var assignable []ast.Expr
+	for _, r := range replacements {
+		if t := replacementType(info, r); t != nil && types.AssignableTo(t, param) {
+			assignable = append(assignable, r)
+		}
+	}
+
+	return assignable
+}
+
+// replacementType returns the type of the replacement, which is either an existing expression or an address or a
+// dereference of an existing expression.
+func replacementType(info *types.Info, expr ast.Expr) types.Type {
+	switch e := expr.(type) {
+	case *ast.StarExpr:
+		if p, ok := info.TypeOf(e.X).Underlying().(*types.Pointer); ok {
+			return p.Elem()
+		}
+		return nil
+	case *ast.UnaryExpr:
+		if t := info.TypeOf(e.X); t != nil {
+			return types.NewPointer(t)
+		}
+		return nil
+	}
+	return info.TypeOf(expr)
+}
+
+// parameterType returns the type of the parameter of the call argument, or nil if the parent is not a call of a
+// function. Arguments of conversions and builtins are not checked against parameters.
+func parameterType(info *types.Info, parent ast.Node, ref *ast.Expr) types.Type {
+	call, ok := parent.(*ast.CallExpr)
+	if !ok || call.Ellipsis.IsValid() {
+		return nil
+	}
+	tv, ok := info.Types[call.Fun]
+	if !ok || tv.IsType() || tv.IsBuiltin() {
+		return nil
+	}
+	sig, ok := tv.Type.Underlying().(*types.Signature)
+	if !ok {
+		return nil
+	}
+
+	for i := range call.Args {
+		if &call.Args[i] != ref {
+			continue
+		}
+		params := sig.Params()
+		if sig.Variadic() && i >= params.Len()-1 {
+			return params.At(params.Len() - 1).Type().(*types.Slice).Elem()
+		}
+		if i < params.Len() {
+			return params.At(i).Type()
+		}
+	}
+
+	return nil
+}
+
+// negatable reports whether the negation of the expression is representable in its type. Only constants can
+// overflow, the constants of untyped expressions are not negated at all, as their type is defined by their context.
+func negatable(info *types.Info, expr ast.Expr) bool {
+	tv, ok := info.Types[expr]
+	if !ok {
+		return false
+	}
+	basic, ok := tv.Type.Underlying().(*types.Basic)
+	if !ok || basic.Info()&types.IsNumeric == 0 {
+		return false
+	}
+	if tv.Value == nil {
+		return true
+	}
+
+	switch {
+	case basic.Info()&types.IsUntyped != 0:
+		return false
+	case basic.Info()&types.IsUnsigned != 0:
+		return constant.Sign(tv.Value) == 0
+	case basic.Info()&types.IsInteger != 0:
+		// The minimum of signed integers has no positive counterpart. The size of int depends on the target
+		// architecture, so the minimums of both sizes are excluded to get the same mutants on every architecture.
+		bits := []int64{types.SizesFor("gc", "amd64").Sizeof(basic) * 8}
+		if basic.Kind() == types.Int {
+			bits = []int64{32, 64}
+		}
+		for _, b := range bits {
+			minimum := constant.Shift(constant.MakeInt64(-1), token.SHL, uint(b-1))
+			if constant.Compare(tv.Value, token.EQL, minimum) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return true
+}
@@ -0,0 +1,16 @@
+package arithmetic
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorArithmeticUnary(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorArithmeticUnary,
+		"../../testdata/arithmetic/unary.go",
+		8,
+	)
+}
@@ -0,0 +1,58 @@
+package branch
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("branch/condition", MutatorCondition)
+}
+
+// MutatorCondition implements a mutator to replace conditions of if and else if statements with "true" and "false",
+// and conditions of for statements with "false". Loop conditions are replaced with "true" by the
+// branch/condition_loop mutator, as such loops might never terminate.
+func MutatorCondition(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	switch n := node.(type) {
+	case *ast.IfStmt:
+		return conditionMutations(pkg, info, &n.Cond, "true", "false")
+	case *ast.ForStmt:
+		return conditionMutations(pkg, info, &n.Cond, "false")
+	}
+	return nil
+}
+
+// conditionMutations returns mutations replacing the condition with the given boolean constants. Conditions holding
+// the last use of a variable are not replaced.
+func conditionMutations(pkg *types.Package, info *types.Info, cond *ast.Expr, values ...string) []mutator.Mutation {
+	original := *cond
+	if original == nil || astutil.HasLastUse(info, original) {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	for _, value := range values {
+		if astutil.IsUniverse(info, original, value) {
+			continue
+		}
+		mutated := ast.NewIdent(value)
+		if !astutil.Compiles(pkg, original.Pos(), mutated, info.TypeOf(original)) {
+			continue
+		}
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				*cond = mutated
+			},
+			Reset: func() {
+				*cond = original
+			},
+		})
+	}
+
+	return mutations
+}
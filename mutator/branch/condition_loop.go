@@ -0,0 +1,22 @@
+package branch
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("branch/condition_loop", MutatorConditionLoop)
+}
+
+// MutatorConditionLoop implements a mutator to replace conditions of for statements with "true". Such loops might never
+// terminate, so the mutator is only enabled if mutants exceeding the exec timeout are counted as killed.
+func MutatorConditionLoop(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	n, ok := node.(*ast.ForStmt)
+	if !ok {
+		return nil
+	}
+	return conditionMutations(pkg, info, &n.Cond, "true")
+}
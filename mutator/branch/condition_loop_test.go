@@ -0,0 +1,16 @@
+package branch
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorConditionLoop(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorConditionLoop,
+		"../../testdata/branch/condition_loop.go",
+		1,
+	)
+}
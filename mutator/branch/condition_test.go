@@ -0,0 +1,16 @@
+package branch
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorCondition(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorCondition,
+		"../../testdata/branch/condition.go",
+		6,
+	)
+}
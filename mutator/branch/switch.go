@@ -0,0 +1,164 @@
+package branch
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"slices"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+)
+
+// switchBody returns the body of switch, type switch and select statements.
+func switchBody(node ast.Node) (*ast.BlockStmt, bool) {
+	switch n := node.(type) {
+	case *ast.SwitchStmt:
+		return n.Body, true
+	case *ast.TypeSwitchStmt:
+		return n.Body, true
+	case *ast.SelectStmt:
+		return n.Body, true
+	}
+	return nil, false
+}
+
+// isDefault reports whether the clause is a default clause of a switch or a select statement.
+func isDefault(clause ast.Stmt) bool {
+	switch c := clause.(type) {
+	case *ast.CaseClause:
+		return c.List == nil
+	case *ast.CommClause:
+		return c.Comm == nil
+	}
+	return false
+}
+
+// endsWithFallthrough reports whether the clause ends with a fallthrough statement.
+func endsWithFallthrough(clause ast.Stmt) bool {
+	c, ok := clause.(*ast.CaseClause)
+	if !ok || len(c.Body) == 0 {
+		return false
+	}
+	b, ok := c.Body[len(c.Body)-1].(*ast.BranchStmt)
+	return ok && b.Tok == token.FALLTHROUGH
+}
+
+// removable reports whether the i-th clause of the statement can be removed, so the mutant still compiles. Clauses
+// which are the target of a fallthrough statement and clauses with last uses of variables are not removable. Type
+// switches declaring a variable need another clause using it, and select statements must not become empty, as an
+// empty select statement blocks forever.
+func removable(info *types.Info, node ast.Node, clauses []ast.Stmt, i int) bool {
+	if i > 0 && endsWithFallthrough(clauses[i-1]) {
+		return false
+	}
+	if astutil.HasLastUse(info, clauses[i]) {
+		return false
+	}
+
+	switch n := node.(type) {
+	case *ast.TypeSwitchStmt:
+		if _, ok := n.Assign.(*ast.AssignStmt); !ok {
+			return true
+		}
+		for j, clause := range clauses {
+			if j != i && usesImplicit(info, clause) {
+				return true
+			}
+		}
+		return false
+	case *ast.SelectStmt:
+		return len(clauses) > 1
+	}
+
+	return true
+}
+
+// usesImplicit reports whether the implicit variable of the type switch clause is used.
+func usesImplicit(info *types.Info, clause ast.Stmt) bool {
+	obj := info.Implicits[clause]
+	if obj == nil {
+		return false
+	}
+
+	used := false
+	ast.Inspect(clause, func(node ast.Node) bool {
+		if ident, ok := node.(*ast.Ident); ok && info.Uses[ident] == obj {
+			used = true
+		}
+		return !used
+	})
+
+	return used
+}
+
+// isTerminating reports whether the statement is a terminating statement as defined by the specification, i.e. a
+// function body ending with it needs no further return statement. Labeled breaks are not resolved, so statements
+// are considered terminating if in doubt.
+func isTerminating(info *types.Info, stmt ast.Stmt) bool {
+	switch s := stmt.(type) {
+	case *ast.ReturnStmt:
+		return true
+	case *ast.BranchStmt:
+		return s.Tok == token.GOTO || s.Tok == token.FALLTHROUGH
+	case *ast.ExprStmt:
+		call, ok := s.X.(*ast.CallExpr)
+		return ok && astutil.IsBuiltin(info, call, "panic")
+	case *ast.BlockStmt:
+		return endsTerminating(info, s.List)
+	case *ast.IfStmt:
+		return s.Else != nil && isTerminating(info, s.Body) && isTerminating(info, s.Else)
+	case *ast.ForStmt:
+		return s.Cond == nil && !hasBreak(s.Body)
+	case *ast.LabeledStmt:
+		return isTerminating(info, s.Stmt)
+	case *ast.SwitchStmt, *ast.TypeSwitchStmt, *ast.SelectStmt:
+		body, _ := switchBody(s)
+		if hasBreak(body) {
+			return false
+		}
+		if _, ok := s.(*ast.SelectStmt); !ok && !slices.ContainsFunc(body.List, isDefault) {
+			return false
+		}
+		for _, clause := range body.List {
+			if !endsTerminating(info, clauseBody(clause)) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// endsTerminating reports whether the last statement of the list is a terminating statement.
+func endsTerminating(info *types.Info, stmts []ast.Stmt) bool {
+	return len(stmts) > 0 && isTerminating(info, stmts[len(stmts)-1])
+}
+
+// clauseBody returns the statements of a case clause or a communication clause.
+func clauseBody(clause ast.Stmt) []ast.Stmt {
+	switch c := clause.(type) {
+	case *ast.CaseClause:
+		return c.Body
+	case *ast.CommClause:
+		return c.Body
+	}
+	return nil
+}
+
+// hasBreak reports whether the body contains an unlabeled break statement referring to the enclosing statement of the
+// body. Breaks of nested loops, switch and select statements and of function literals are ignored.
+func hasBreak(body *ast.BlockStmt) bool {
+	found := false
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.ForStmt, *ast.RangeStmt, *ast.SwitchStmt, *ast.TypeSwitchStmt, *ast.SelectStmt, *ast.FuncLit:
+			return false
+		case *ast.BranchStmt:
+			if n.Tok == token.BREAK && n.Label == nil {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
@@ -0,0 +1,45 @@
+package branch
+
+import (
+	"go/ast"
+	"go/types"
+	"slices"
+
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("branch/switch_case", MutatorSwitchCase)
+}
+
+// MutatorSwitchCase implements a mutator to remove individual cases of switch and type switch statements. Default
+// clauses are removed by the branch/switch_default mutator and cases of select statements are removed by the
+// concurrency/select_case mutator.
+func MutatorSwitchCase(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	switch node.(type) {
+	case *ast.SwitchStmt, *ast.TypeSwitchStmt:
+	default:
+		return nil
+	}
+	body, _ := switchBody(node)
+
+	var mutations []mutator.Mutation
+
+	original := body.List
+	for i, clause := range original {
+		if isDefault(clause) || !removable(info, node, original, i) {
+			continue
+		}
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				body.List = slices.Delete(slices.Clone(original), i, i+1)
+			},
+			Reset: func() {
+				body.List = original
+			},
+		})
+	}
+
+	return mutations
+}
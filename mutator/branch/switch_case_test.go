@@ -0,0 +1,16 @@
+package branch
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorSwitchCase(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorSwitchCase,
+		"../../testdata/branch/switch_case.go",
+		4,
+	)
+}
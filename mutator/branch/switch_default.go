@@ -0,0 +1,43 @@
+package branch
+
+import (
+	"go/ast"
+	"go/types"
+	"slices"
+
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("branch/switch_default", MutatorSwitchDefault)
+}
+
+// MutatorSwitchDefault implements a mutator to remove default clauses of switch, type switch and select statements.
+// Without a default clause a select statement blocks until one of its cases can proceed. Default clauses of terminating
+// switch statements are not removed, as the switch statement could be required to end a function with results.
+func MutatorSwitchDefault(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	body, ok := switchBody(node)
+	if !ok {
+		return nil
+	}
+
+	original := body.List
+	i := slices.IndexFunc(original, isDefault)
+	if i < 0 || !removable(info, node, original, i) {
+		return nil
+	}
+	if _, ok := node.(*ast.SelectStmt); !ok && isTerminating(info, node.(ast.Stmt)) {
+		return nil
+	}
+
+	return []mutator.Mutation{
+		{
+			Change: func() {
+				body.List = slices.Delete(slices.Clone(original), i, i+1)
+			},
+			Reset: func() {
+				body.List = original
+			},
+		},
+	}
+}
@@ -0,0 +1,16 @@
+package branch
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorSwitchDefault(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorSwitchDefault,
+		"../../testdata/branch/switch_default.go",
+		4,
+	)
+}
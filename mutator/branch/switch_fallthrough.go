@@ -0,0 +1,75 @@
+package branch
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("branch/switch_fallthrough", MutatorSwitchFallthrough)
+}
+
+// MutatorSwitchFallthrough implements a mutator to toggle fallthrough statements of switch statements, i.e. existing
+// fallthrough statements are removed and case clauses without one fall through to the next clause. Clauses ending with
+// a return, a branch statement or a panic, and the last clause of a switch do not get a fallthrough statement.
+// Fallthrough statements of terminating switch statements are only removed if the clause still ends with a terminating
+// statement, as the switch statement could be required to end a function with results.
+func MutatorSwitchFallthrough(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	// Fallthrough statements are not permitted in type switches.
+	n, ok := node.(*ast.SwitchStmt)
+	if !ok {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	for i, stmt := range n.Body.List {
+		clause := stmt.(*ast.CaseClause)
+		original := clause.Body
+
+		var mutated []ast.Stmt
+		switch {
+		case endsWithFallthrough(clause):
+			mutated = original[:len(original)-1]
+			if isTerminating(info, n) && !endsTerminating(info, mutated) {
+				continue
+			}
+		case i < len(n.Body.List)-1 && !terminates(info, original):
+			mutated = append(original[:len(original):len(original)], &ast.BranchStmt{Tok: token.FALLTHROUGH})
+		default:
+			continue
+		}
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				clause.Body = mutated
+			},
+			Reset: func() {
+				clause.Body = original
+			},
+		})
+	}
+
+	return mutations
+}
+
+// terminates reports whether the statements end with a return, a branch statement or a panic.
+func terminates(info *types.Info, stmts []ast.Stmt) bool {
+	if len(stmts) == 0 {
+		return false
+	}
+
+	switch s := stmts[len(stmts)-1].(type) {
+	case *ast.ReturnStmt, *ast.BranchStmt:
+		return true
+	case *ast.ExprStmt:
+		call, ok := s.X.(*ast.CallExpr)
+		return ok && astutil.IsBuiltin(info, call, "panic")
+	}
+
+	return false
+}
@@ -0,0 +1,16 @@
+package branch
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorSwitchFallthrough(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorSwitchFallthrough,
+		"../../testdata/branch/switch_fallthrough.go",
+		3,
+	)
+}
@@ -0,0 +1,95 @@
+package branch
+
+import (
+	"go/ast"
+	"go/types"
+	"slices"
+
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("branch/switch_reorder", MutatorSwitchReorder)
+}
+
+// MutatorSwitchReorder implements a mutator to swap adjacent cases of switch and type switch statements, if more than
+// one of the cases can match, i.e. cases of switch statements without a tag, cases with non-constant expressions and
+// type switch cases of overlapping interface types. Swapping other cases does not change the semantics. Cases of select
+// statements are chosen at random, so they are not swapped either.
+func MutatorSwitchReorder(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	switch node.(type) {
+	case *ast.SwitchStmt, *ast.TypeSwitchStmt:
+	default:
+		return nil
+	}
+	body, _ := switchBody(node)
+
+	var mutations []mutator.Mutation
+
+	original := body.List
+	for i := 0; i+1 < len(original); i++ {
+		a, b := original[i].(*ast.CaseClause), original[i+1].(*ast.CaseClause)
+		if isDefault(a) || isDefault(b) || endsWithFallthrough(a) || endsWithFallthrough(b) ||
+			(i > 0 && endsWithFallthrough(original[i-1])) || !overlapping(info, node, a, b) {
+			continue
+		}
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				mutated := slices.Clone(original)
+				mutated[i], mutated[i+1] = b, a
+				body.List = mutated
+			},
+			Reset: func() {
+				body.List = original
+			},
+		})
+	}
+
+	return mutations
+}
+
+// overlapping reports whether both case clauses can match the same value.
+func overlapping(info *types.Info, node ast.Node, a *ast.CaseClause, b *ast.CaseClause) bool {
+	switch n := node.(type) {
+	case *ast.SwitchStmt:
+		if n.Tag == nil {
+			return true
+		}
+		for _, e := range slices.Concat(a.List, b.List) {
+			if tv, ok := info.Types[e]; !ok || tv.Value == nil {
+				return true
+			}
+		}
+	case *ast.TypeSwitchStmt:
+		for _, x := range a.List {
+			for _, y := range b.List {
+				if overlappingTypes(info.TypeOf(x), info.TypeOf(y)) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// overlappingTypes reports whether a value can match both types of type switch cases.
+func overlappingTypes(x types.Type, y types.Type) bool {
+	if x == nil || y == nil || x == types.Typ[types.UntypedNil] || y == types.Typ[types.UntypedNil] {
+		return false
+	}
+
+	ix, xok := x.Underlying().(*types.Interface)
+	iy, yok := y.Underlying().(*types.Interface)
+	switch {
+	case xok && yok:
+		return true
+	case xok:
+		return types.Implements(y, ix)
+	case yok:
+		return types.Implements(x, iy)
+	}
+
+	return false
+}
@@ -0,0 +1,16 @@
+package branch
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorSwitchReorder(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorSwitchReorder,
+		"../../testdata/branch/switch_reorder.go",
+		3,
+	)
+}
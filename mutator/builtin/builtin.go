@@ -0,0 +1,94 @@
+package builtin
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+// builtinCall returns the called builtin of the expression.
+func builtinCall(info *types.Info, expr ast.Expr) (*ast.CallExpr, *types.Builtin, bool) {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		return nil, nil, false
+	}
+	ident, ok := ast.Unparen(call.Fun).(*ast.Ident)
+	if !ok {
+		return nil, nil, false
+	}
+	b, ok := info.Uses[ident].(*types.Builtin)
+	return call, b, ok
+}
+
+// renaming returns a mutation calling the builtin with the given name instead. The last return argument is false if
+// the name does not refer to the builtin at the position of the call, e.g. if it is shadowed by a variable.
+func renaming(pkg *types.Package, call *ast.CallExpr, name string) (mutator.Mutation, bool) {
+	if pkg == nil {
+		return mutator.Mutation{}, false
+	}
+	scope := pkg.Scope().Innermost(call.Pos())
+	if scope == nil {
+		return mutator.Mutation{}, false
+	}
+	if _, obj := scope.LookupParent(name, call.Pos()); obj != types.Universe.Lookup(name) {
+		return mutator.Mutation{}, false
+	}
+
+	original := call.Fun
+	mutated := ast.NewIdent(name)
+	return mutator.Mutation{
+		Change: func() {
+			call.Fun = mutated
+		},
+		Reset: func() {
+			call.Fun = original
+		},
+	}, true
+}
+
+// callRemovals returns mutations removing calls of the builtin with the given name, including deferred calls. Removed
+// calls are replaced by noop statements, which keep used identifiers alive.
+func callRemovals(pkg *types.Package, info *types.Info, node ast.Node, name string) []mutator.Mutation {
+	list := astutil.StatementList(node)
+	if list == nil {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	l := *list
+	for i, stmt := range l {
+		var expr ast.Expr
+		switch s := stmt.(type) {
+		case *ast.ExprStmt:
+			expr = s.X
+		case *ast.DeferStmt:
+			expr = s.Call
+		default:
+			continue
+		}
+		if _, b, ok := builtinCall(info, expr); !ok || b.Name() != name {
+			continue
+		}
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				l[i] = astutil.CreateNoopOfStatements(pkg, info, stmt)
+			},
+			Reset: func() {
+				l[i] = stmt
+			},
+		})
+	}
+
+	return mutations
+}
+
+// isZero reports whether the expression is the constant zero.
+func isZero(info *types.Info, expr ast.Expr) bool {
+	tv, ok := info.Types[expr]
+	return ok && tv.Value != nil && tv.Value.Kind() == constant.Int && constant.Sign(tv.Value) == 0
+}
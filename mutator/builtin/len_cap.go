@@ -0,0 +1,46 @@
+package builtin
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("builtin/len_cap", MutatorLenCap)
+}
+
+// MutatorLenCap implements a mutator to swap calls of the len and cap builtins. Only lengths of slices and channels
+// are mutated, as cap is not defined for strings and maps, and both builtins return the same value for arrays.
+func MutatorLenCap(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	n, ok := node.(*ast.CallExpr)
+	if !ok || len(n.Args) != 1 {
+		return nil
+	}
+	_, b, ok := builtinCall(info, n)
+	if !ok {
+		return nil
+	}
+	switch info.TypeOf(n.Args[0]).Underlying().(type) {
+	case *types.Slice, *types.Chan:
+	default:
+		return nil
+	}
+
+	var name string
+	switch b.Name() {
+	case "len":
+		name = "cap"
+	case "cap":
+		name = "len"
+	default:
+		return nil
+	}
+
+	m, ok := renaming(pkg, n, name)
+	if !ok {
+		return nil
+	}
+	return []mutator.Mutation{m}
+}
@@ -0,0 +1,16 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorLenCap(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorLenCap,
+		"../../testdata/builtin/len_cap.go",
+		3,
+	)
+}
@@ -0,0 +1,45 @@
+package builtin
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("builtin/make", MutatorMake)
+}
+
+// MutatorMake implements a mutator to replace the length of slices created by the make builtin with zero, e.g.
+// "make([]T, n)" is replaced by "make([]T, 0)". The capacity is kept.
+func MutatorMake(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	n, ok := node.(*ast.CallExpr)
+	if !ok || len(n.Args) < 2 {
+		return nil
+	}
+	if _, b, ok := builtinCall(info, n); !ok || b.Name() != "make" {
+		return nil
+	}
+	if _, ok := info.TypeOf(n.Args[0]).Underlying().(*types.Slice); !ok {
+		return nil
+	}
+	if isZero(info, n.Args[1]) || astutil.HasLastUse(info, n.Args[1]) {
+		return nil
+	}
+
+	original := n.Args[1]
+	mutated := &ast.BasicLit{Kind: token.INT, Value: "0"}
+	return []mutator.Mutation{
+		{
+			Change: func() {
+				n.Args[1] = mutated
+			},
+			Reset: func() {
+				n.Args[1] = original
+			},
+		},
+	}
+}
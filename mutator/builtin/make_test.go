@@ -0,0 +1,16 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorMake(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorMake,
+		"../../testdata/builtin/make.go",
+		2,
+	)
+}
@@ -0,0 +1,44 @@
+package builtin
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("builtin/min_max", MutatorMinMax)
+}
+
+var minMaxMutations = map[string]string{
+	"min": "max",
+	"max": "min",
+}
+
+// MutatorMinMax implements a mutator to swap calls of the min and max builtins. Calls with a single argument are not
+// mutated, as both builtins return the argument.
+func MutatorMinMax(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	n, ok := node.(*ast.CallExpr)
+	if !ok || len(n.Args) < 2 {
+		return nil
+	}
+	// Constant results can be used in contexts such as array lengths, where changed values do not compile.
+	if tv, ok := info.Types[n]; !ok || tv.Value != nil {
+		return nil
+	}
+	_, b, ok := builtinCall(info, n)
+	if !ok {
+		return nil
+	}
+	name, ok := minMaxMutations[b.Name()]
+	if !ok {
+		return nil
+	}
+
+	m, ok := renaming(pkg, n, name)
+	if !ok {
+		return nil
+	}
+	return []mutator.Mutation{m}
+}
@@ -0,0 +1,16 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorMinMax(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorMinMax,
+		"../../testdata/builtin/min_max.go",
+		3,
+	)
+}
@@ -0,0 +1,30 @@
+package builtin
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("builtin/delete", MutatorDelete)
+	mutator.Register("builtin/clear", MutatorClear)
+	mutator.Register("builtin/copy", MutatorCopy)
+}
+
+// MutatorDelete implements a mutator to remove calls of the delete builtin, including deferred ones.
+func MutatorDelete(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	return callRemovals(pkg, info, node, "delete")
+}
+
+// MutatorClear implements a mutator to remove calls of the clear builtin, including deferred ones.
+func MutatorClear(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	return callRemovals(pkg, info, node, "clear")
+}
+
+// MutatorCopy implements a mutator to remove calls of the copy builtin, including deferred ones. Calls whose result is
+// used are not removed.
+func MutatorCopy(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	return callRemovals(pkg, info, node, "copy")
+}
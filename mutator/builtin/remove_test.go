@@ -0,0 +1,34 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorDelete(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorDelete,
+		"../../testdata/builtin/delete.go",
+		2,
+	)
+}
+
+func TestMutatorClear(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorClear,
+		"../../testdata/builtin/clear.go",
+		2,
+	)
+}
+
+func TestMutatorCopy(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorCopy,
+		"../../testdata/builtin/copy.go",
+		1,
+	)
+}
@@ -0,0 +1,94 @@
+package call
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("call/passthrough", MutatorPassthrough)
+}
+
+// MutatorPassthrough implements a mutator to replace calls with their first argument if the argument has the same
+// type as the result, e.g. "strings.TrimSpace(s)" is replaced by "s". Method calls are replaced by their receiver if
+// the receiver has the same type as the result, which elides a call of a method chain.
+func MutatorPassthrough(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	var mutations []mutator.Mutation
+
+	for _, ref := range astutil.ChildExpressions(node) {
+		call, ok := ast.Unparen(*ref).(*ast.CallExpr)
+		if !ok {
+			continue
+		}
+		tv, ok := info.Types[call]
+		if !ok || !tv.IsValue() || tv.Value != nil {
+			continue
+		}
+		if fun, ok := info.Types[call.Fun]; !ok || fun.IsType() || fun.IsBuiltin() {
+			// Conversions keep their operand anyway, builtins are mutated by specialized mutators.
+			continue
+		}
+
+		original := *ref
+		for _, passed := range passthroughCandidates(info, call) {
+			if !isValueOf(info, passed.expr, tv.Type) || astutil.HasLastUse(info, passed.removed...) {
+				continue
+			}
+
+			mutated := passed.expr
+			mutations = append(mutations, mutator.Mutation{
+				Change: func() {
+					*ref = mutated
+				},
+				Reset: func() {
+					*ref = original
+				},
+			})
+		}
+	}
+
+	return mutations
+}
+
+// passthrough is an expression which can replace a call, together with the parts of the call removed by the
+// replacement.
+type passthrough struct {
+	expr    ast.Expr
+	removed []ast.Node
+}
+
+// passthroughCandidates returns the receiver of a method call and the first argument of the call. Variadic arguments
+// passed with "..." are not candidates, as their type differs from the type of the parameter.
+func passthroughCandidates(info *types.Info, call *ast.CallExpr) []passthrough {
+	var candidates []passthrough
+
+	if sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr); ok {
+		if s, ok := info.Selections[sel]; ok && s.Kind() == types.MethodVal {
+			removed := make([]ast.Node, len(call.Args))
+			for i, arg := range call.Args {
+				removed[i] = arg
+			}
+			candidates = append(candidates, passthrough{expr: sel.X, removed: removed})
+		}
+	}
+
+	if len(call.Args) > 0 && !(len(call.Args) == 1 && call.Ellipsis.IsValid()) {
+		removed := []ast.Node{call.Fun}
+		for _, arg := range call.Args[1:] {
+			removed = append(removed, arg)
+		}
+		candidates = append(candidates, passthrough{expr: call.Args[0], removed: removed})
+	}
+
+	return candidates
+}
+
+// isValueOf reports whether the expression is a value of the given type. Type arguments of builtins such as make are
+// not values.
+func isValueOf(info *types.Info, expr ast.Expr, t types.Type) bool {
+	tv, ok := info.Types[expr]
+	return ok && tv.IsValue() && types.Identical(tv.Type, t)
+}
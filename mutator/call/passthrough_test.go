@@ -0,0 +1,16 @@
+package call
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorPassthrough(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorPassthrough,
+		"../../testdata/call/passthrough.go",
+		5,
+	)
+}
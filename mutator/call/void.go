@@ -0,0 +1,76 @@
+package call
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("call/void", MutatorVoid)
+}
+
+// MutatorVoid implements a mutator to remove calls whose results are discarded, i.e. calls returning results used as
+// statements and calls assigned to blank identifiers, e.g. "_ = f.Close()".
+func MutatorVoid(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	list := astutil.StatementList(node)
+	if list == nil {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	for i, stmt := range *list {
+		if !discardsResults(info, stmt) {
+			continue
+		}
+
+		l := *list
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				l[i] = astutil.CreateNoopOfStatements(pkg, info, stmt)
+			},
+			Reset: func() {
+				l[i] = stmt
+			},
+		})
+	}
+
+	return mutations
+}
+
+// discardsResults reports whether the statement is a call with discarded results.
+func discardsResults(info *types.Info, stmt ast.Stmt) bool {
+	var expr ast.Expr
+	switch s := stmt.(type) {
+	case *ast.ExprStmt:
+		expr = s.X
+	case *ast.AssignStmt:
+		if len(s.Rhs) != 1 || (s.Tok != token.ASSIGN && s.Tok != token.DEFINE) {
+			return false
+		}
+		for _, lhs := range s.Lhs {
+			if ident, ok := lhs.(*ast.Ident); !ok || ident.Name != "_" {
+				return false
+			}
+		}
+		expr = s.Rhs[0]
+	default:
+		return false
+	}
+
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	switch t := info.TypeOf(call).(type) {
+	case nil:
+		return false
+	case *types.Tuple:
+		return t.Len() > 0
+	}
+	return true
+}
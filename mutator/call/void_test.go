@@ -0,0 +1,16 @@
+package call
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorVoid(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorVoid,
+		"../../testdata/call/void.go",
+		3,
+	)
+}
@@ -0,0 +1,27 @@
+package commaok
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("commaok/check", MutatorCheck)
+}
+
+// MutatorCheck implements a mutator to invert checks of comma-ok assignments of map lookups and type assertions in
+// if statements, e.g. "if _, ok := m[k]; ok" is replaced by "if _, ok := m[k]; !ok". Only the result is negated, so
+// other operands of the condition are kept, e.g. "ok && v > 0" is replaced by "!ok && v > 0".
+func MutatorCheck(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	n, ok := node.(*ast.IfStmt)
+	if !ok || n.Init == nil {
+		return nil
+	}
+	obj, ok := commaOk(info, n.Init)
+	if !ok {
+		return nil
+	}
+	return negateFirstUse(info, obj, n, n.Cond)
+}
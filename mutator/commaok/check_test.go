@@ -0,0 +1,16 @@
+package commaok
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorCheck(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorCheck,
+		"../../testdata/commaok/check.go",
+		3,
+	)
+}
@@ -0,0 +1,93 @@
+package commaok
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+// commaOk returns the object of the boolean result of a comma-ok assignment of a map lookup or a type assertion, e.g.
+// "v, ok := m[k]" or "v, ok := x.(T)". The blank identifier is not reported.
+func commaOk(info *types.Info, stmt ast.Stmt) (types.Object, bool) {
+	assign, ok := stmt.(*ast.AssignStmt)
+	if !ok || len(assign.Lhs) != 2 || len(assign.Rhs) != 1 {
+		return nil, false
+	}
+
+	switch rhs := ast.Unparen(assign.Rhs[0]).(type) {
+	case *ast.TypeAssertExpr:
+	case *ast.IndexExpr:
+		if _, ok := info.TypeOf(rhs.X).Underlying().(*types.Map); !ok {
+			return nil, false
+		}
+	default:
+		return nil, false
+	}
+
+	ident, ok := assign.Lhs[1].(*ast.Ident)
+	if !ok || ident.Name == "_" {
+		return nil, false
+	}
+	obj := info.ObjectOf(ident)
+	return obj, obj != nil
+}
+
+// negateFirstUse returns a mutation negating the first use of the object in the given nodes, which are children of
+// the given parent. An existing negation is removed instead. The first use is not mutated if it is not a value, e.g.
+// if the object is assigned a new value.
+func negateFirstUse(info *types.Info, obj types.Object, parent ast.Node, nodes ...ast.Node) []mutator.Mutation {
+	var (
+		found   bool
+		ref     *ast.Expr
+		mutated ast.Expr
+	)
+
+	for _, node := range nodes {
+		if node == nil || found {
+			continue
+		}
+		ast.PreorderStack(node, []ast.Node{parent}, func(n ast.Node, stack []ast.Node) bool {
+			if found {
+				return false
+			}
+			ident, ok := n.(*ast.Ident)
+			if !ok || info.Uses[ident] != obj {
+				return true
+			}
+			found = true
+
+			var target, container ast.Node = ident, stack[len(stack)-1]
+			mutated = &ast.UnaryExpr{Op: token.NOT, X: ident}
+			if u, ok := container.(*ast.UnaryExpr); ok && u.Op == token.NOT && len(stack) > 1 {
+				target, container = u, stack[len(stack)-2]
+				mutated = ident
+			}
+			for _, r := range astutil.ChildExpressions(container) {
+				if *r == target {
+					ref = r
+				}
+			}
+
+			return false
+		})
+	}
+
+	if ref == nil {
+		return nil
+	}
+
+	original := *ref
+	return []mutator.Mutation{
+		{
+			Change: func() {
+				*ref = mutated
+			},
+			Reset: func() {
+				*ref = original
+			},
+		},
+	}
+}
@@ -0,0 +1,39 @@
+package commaok
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("commaok/first_use", MutatorFirstUse)
+}
+
+// MutatorFirstUse implements a mutator to force the result of comma-ok assignments of map lookups and type assertions
+// to the opposite value by negating its first use, e.g. "if !ok" following "v, ok := m[k]" is replaced by "if ok".
+func MutatorFirstUse(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	list := astutil.StatementList(node)
+	if list == nil {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	for i, stmt := range *list {
+		obj, ok := commaOk(info, stmt)
+		if !ok {
+			continue
+		}
+
+		rest := make([]ast.Node, 0, len(*list)-i-1)
+		for _, s := range (*list)[i+1:] {
+			rest = append(rest, s)
+		}
+		mutations = append(mutations, negateFirstUse(info, obj, node, rest...)...)
+	}
+
+	return mutations
+}
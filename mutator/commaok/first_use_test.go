@@ -0,0 +1,16 @@
+package commaok
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorFirstUse(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorFirstUse,
+		"../../testdata/commaok/first_use.go",
+		3,
+	)
+}
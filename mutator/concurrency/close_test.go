@@ -0,0 +1,16 @@
+package concurrency
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorClose(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorClose,
+		"../../testdata/concurrency/close.go",
+		2,
+	)
+}
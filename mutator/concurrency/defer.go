@@ -0,0 +1,32 @@
+package concurrency
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("concurrency/defer", MutatorDefer)
+}
+
+// MutatorDefer implements a mutator to remove defer statements.
+func MutatorDefer(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	list := astutil.StatementList(node)
+	if list == nil {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	for i, stmt := range *list {
+		if _, ok := stmt.(*ast.DeferStmt); !ok {
+			continue
+		}
+		mutations = append(mutations, removal(pkg, info, *list, i))
+	}
+
+	return mutations
+}
@@ -0,0 +1,16 @@
+package concurrency
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorDefer(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorDefer,
+		"../../testdata/concurrency/defer.go",
+		4,
+	)
+}
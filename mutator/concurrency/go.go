@@ -0,0 +1,41 @@
+package concurrency
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("concurrency/go", MutatorGo)
+}
+
+// MutatorGo implements a mutator to turn go statements into synchronous calls.
+func MutatorGo(_ *types.Package, _ *types.Info, node ast.Node) []mutator.Mutation {
+	list := astutil.StatementList(node)
+	if list == nil {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	for i, stmt := range *list {
+		g, ok := stmt.(*ast.GoStmt)
+		if !ok {
+			continue
+		}
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				(*list)[i] = &ast.ExprStmt{X: g.Call}
+			},
+			Reset: func() {
+				(*list)[i] = g
+			},
+		})
+	}
+
+	return mutations
+}
@@ -0,0 +1,16 @@
+package concurrency
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorGo(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorGo,
+		"../../testdata/concurrency/go.go",
+		2,
+	)
+}
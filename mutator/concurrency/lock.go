@@ -0,0 +1,68 @@
+package concurrency
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("concurrency/lock", MutatorLock)
+}
+
+// unlocks maps locking methods of sync.Mutex and sync.RWMutex to their unlocking counterparts.
+var unlocks = map[string]string{
+	"Lock":  "Unlock",
+	"RLock": "RUnlock",
+}
+
+// MutatorLock implements a mutator to remove pairs of locking and unlocking calls of sync.Mutex and sync.RWMutex. The
+// unlocking call has to follow the locking call in the same statement list, it can be deferred.
+func MutatorLock(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	list := astutil.StatementList(node)
+	if list == nil {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	for i, stmt := range *list {
+		if _, ok := stmt.(*ast.ExprStmt); !ok {
+			continue
+		}
+		recv, method, ok := mutexCall(info, stmt, "Lock", "RLock")
+		if !ok {
+			continue
+		}
+
+		for j := i + 1; j < len(*list); j++ {
+			unlockRecv, unlockMethod, ok := mutexCall(info, (*list)[j], "Unlock", "RUnlock")
+			if !ok || unlockMethod != unlocks[method] || unlockRecv != recv {
+				continue
+			}
+			mutations = append(mutations, removal(pkg, info, *list, i, j))
+			break
+		}
+	}
+
+	return mutations
+}
+
+// mutexCall returns the printed receiver and the name of the called method if the statement calls one of the named
+// methods of sync.Mutex or sync.RWMutex.
+func mutexCall(info *types.Info, stmt ast.Stmt, names ...string) (string, string, bool) {
+	call, ok := statementCall(stmt)
+	if !ok {
+		return "", "", false
+	}
+	sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return "", "", false
+	}
+	if !astutil.IsMethod(info, call, "sync", "Mutex", names...) && !astutil.IsMethod(info, call, "sync", "RWMutex", names...) {
+		return "", "", false
+	}
+	return types.ExprString(sel.X), sel.Sel.Name, true
+}
@@ -0,0 +1,16 @@
+package concurrency
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorLock(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorLock,
+		"../../testdata/concurrency/lock.go",
+		2,
+	)
+}
@@ -0,0 +1,43 @@
+package concurrency
+
+import (
+	"go/ast"
+	"go/types"
+	"slices"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("concurrency/select_case", MutatorSelectCase)
+}
+
+// MutatorSelectCase implements a mutator to remove individual cases of select statements. Cases of select statements
+// with a single case are not removed, as an empty select statement blocks forever.
+func MutatorSelectCase(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	n, ok := node.(*ast.SelectStmt)
+	if !ok || len(n.Body.List) < 2 {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	original := n.Body.List
+	for i, clause := range original {
+		if astutil.HasLastUse(info, clause) {
+			continue
+		}
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				n.Body.List = slices.Delete(slices.Clone(original), i, i+1)
+			},
+			Reset: func() {
+				n.Body.List = original
+			},
+		})
+	}
+
+	return mutations
+}
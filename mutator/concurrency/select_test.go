@@ -0,0 +1,16 @@
+package concurrency
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorSelectCase(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorSelectCase,
+		"../../testdata/concurrency/select.go",
+		2,
+	)
+}
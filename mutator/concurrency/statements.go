@@ -0,0 +1,46 @@
+package concurrency
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+// removal returns a mutation replacing the statements with the given indices of the list by noop statements, which
+// keep used identifiers alive.
+func removal(pkg *types.Package, info *types.Info, list []ast.Stmt, indices ...int) mutator.Mutation {
+	original := make([]ast.Stmt, len(indices))
+	for i, index := range indices {
+		original[i] = list[index]
+	}
+
+	return mutator.Mutation{
+		Change: func() {
+			for i, index := range indices {
+				list[index] = astutil.CreateNoopOfStatements(pkg, info, original[i])
+			}
+		},
+		Reset: func() {
+			for i, index := range indices {
+				list[index] = original[i]
+			}
+		},
+	}
+}
+
+// statementCall returns the call of an expression statement or a defer statement.
+func statementCall(stmt ast.Stmt) (*ast.CallExpr, bool) {
+	var expr ast.Expr
+	switch s := stmt.(type) {
+	case *ast.ExprStmt:
+		expr = s.X
+	case *ast.DeferStmt:
+		expr = s.Call
+	default:
+		return nil, false
+	}
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	return call, ok
+}
@@ -0,0 +1,33 @@
+package concurrency
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("concurrency/waitgroup", MutatorWaitGroup)
+}
+
+// MutatorWaitGroup implements a mutator to remove calls of Add and Done of sync.WaitGroup, including deferred ones.
+func MutatorWaitGroup(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	list := astutil.StatementList(node)
+	if list == nil {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	for i, stmt := range *list {
+		call, ok := statementCall(stmt)
+		if !ok || !astutil.IsMethod(info, call, "sync", "WaitGroup", "Add", "Done") {
+			continue
+		}
+		mutations = append(mutations, removal(pkg, info, *list, i))
+	}
+
+	return mutations
+}
@@ -0,0 +1,16 @@
+package concurrency
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorWaitGroup(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorWaitGroup,
+		"../../testdata/concurrency/waitgroup.go",
+		2,
+	)
+}
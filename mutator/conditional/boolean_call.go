@@ -0,0 +1,63 @@
+package conditional
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("conditional/boolean_call", MutatorConditionalBooleanCall)
+}
+
+// MutatorConditionalBooleanCall implements a mutator to replace calls returning a boolean, e.g. strings.HasPrefix,
+// with "true" and "false".
+func MutatorConditionalBooleanCall(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	var mutations []mutator.Mutation
+
+	for _, ref := range astutil.ChildExpressions(node) {
+		call, ok := ast.Unparen(*ref).(*ast.CallExpr)
+		if !ok || !isBooleanCall(info, call) || astutil.HasLastUse(info, call) {
+			continue
+		}
+
+		original := *ref
+		for _, value := range []string{"true", "false"} {
+			mutated := ast.NewIdent(value)
+			if !astutil.Compiles(pkg, call.Pos(), mutated, info.TypeOf(call)) {
+				continue
+			}
+
+			mutations = append(mutations, mutator.Mutation{
+				Change: func() {
+					*ref = mutated
+				},
+				Reset: func() {
+					*ref = original
+				},
+			})
+		}
+	}
+
+	return mutations
+}
+
+// isBooleanCall reports whether the expression is a call of a function or a method returning a value of an underlying
+// boolean type. Conversions are not reported.
+func isBooleanCall(info *types.Info, expr ast.Expr) bool {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	if tv, ok := info.Types[call.Fun]; !ok || tv.IsType() {
+		return false
+	}
+	tv, ok := info.Types[call]
+	if !ok || !tv.IsValue() || tv.Value != nil {
+		return false
+	}
+	b, ok := tv.Type.Underlying().(*types.Basic)
+	return ok && b.Info()&types.IsBoolean != 0
+}
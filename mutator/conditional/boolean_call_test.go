@@ -0,0 +1,16 @@
+package conditional
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorConditionalBooleanCall(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorConditionalBooleanCall,
+		"../../testdata/conditional/boolean_call.go",
+		8,
+	)
+}
@@ -0,0 +1,43 @@
+package conditional
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("conditional/boolean_literal", MutatorConditionalBooleanLiteral)
+}
+
+// MutatorConditionalBooleanLiteral implements a mutator to flip boolean literals, i.e. "true" is replaced by "false" and
+// vice versa.
+func MutatorConditionalBooleanLiteral(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	var mutations []mutator.Mutation
+
+	for _, ref := range astutil.ChildExpressions(node) {
+		var mutated string
+		switch {
+		case astutil.IsUniverse(info, *ref, "true"):
+			mutated = "false"
+		case astutil.IsUniverse(info, *ref, "false"):
+			mutated = "true"
+		default:
+			continue
+		}
+
+		original := *ref
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				*ref = ast.NewIdent(mutated)
+			},
+			Reset: func() {
+				*ref = original
+			},
+		})
+	}
+
+	return mutations
+}
@@ -0,0 +1,16 @@
+package conditional
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorConditionalBooleanLiteral(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorConditionalBooleanLiteral,
+		"../../testdata/conditional/boolean_literal.go",
+		3,
+	)
+}
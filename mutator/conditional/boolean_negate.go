@@ -0,0 +1,76 @@
+package conditional
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("conditional/boolean_negate", MutatorConditionalBooleanNegate)
+}
+
+// MutatorConditionalBooleanNegate implements a mutator to negate method calls returning a boolean in conditions of if
+// and for statements, e.g. "if a && s.Valid()" is replaced by "if a && !s.Valid()". Operands of logical operators
+// are searched for calls, calls which are negated already are not mutated.
+func MutatorConditionalBooleanNegate(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	var cond *ast.Expr
+	switch n := node.(type) {
+	case *ast.IfStmt:
+		cond = &n.Cond
+	case *ast.ForStmt:
+		cond = &n.Cond
+	default:
+		return nil
+	}
+	if *cond == nil {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	for _, ref := range conditionOperands(cond) {
+		call, ok := (*ref).(*ast.CallExpr)
+		if !ok || !isBooleanCall(info, call) || !isMethodCall(info, call) {
+			continue
+		}
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				*ref = astutil.CreateNegation(call)
+			},
+			Reset: func() {
+				*ref = call
+			},
+		})
+	}
+
+	return mutations
+}
+
+// conditionOperands returns the condition and its operands of logical operators, recursively.
+func conditionOperands(ref *ast.Expr) []*ast.Expr {
+	refs := []*ast.Expr{ref}
+	switch x := (*ref).(type) {
+	case *ast.ParenExpr:
+		refs = append(refs, conditionOperands(&x.X)...)
+	case *ast.BinaryExpr:
+		if x.Op == token.LAND || x.Op == token.LOR {
+			refs = append(refs, conditionOperands(&x.X)...)
+			refs = append(refs, conditionOperands(&x.Y)...)
+		}
+	}
+	return refs
+}
+
+func isMethodCall(info *types.Info, call *ast.CallExpr) bool {
+	sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	s, ok := info.Selections[sel]
+	return ok && s.Kind() == types.MethodVal
+}
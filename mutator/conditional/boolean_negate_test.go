@@ -0,0 +1,16 @@
+package conditional
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorConditionalBooleanNegate(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorConditionalBooleanNegate,
+		"../../testdata/conditional/boolean_negate.go",
+		2,
+	)
+}
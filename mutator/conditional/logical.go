@@ -0,0 +1,60 @@
+package conditional
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("conditional/logical", MutatorConditionalLogical)
+}
+
+var logicalMutations = map[token.Token]token.Token{
+	token.LAND: token.LOR,
+	token.LOR:  token.LAND,
+}
+
+// MutatorConditionalLogical implements a mutator to swap logical operators, i.e. "&&" is replaced by "||" and vice
+// versa.
+func MutatorConditionalLogical(_ *types.Package, _ *types.Info, node ast.Node) []mutator.Mutation {
+	n, ok := node.(*ast.BinaryExpr)
+	if !ok {
+		return nil
+	}
+
+	original := n.Op
+	mutated, ok := logicalMutations[n.Op]
+	if !ok {
+		return nil
+	}
+
+	x, y := n.X, n.Y
+
+	return []mutator.Mutation{
+		{
+			Change: func() {
+				n.Op = mutated
+				// The printer does not add parentheses, so operands binding weaker than the new operator have to be
+				// enclosed explicitly.
+				n.X = parenthesize(x, mutated)
+				n.Y = parenthesize(y, mutated)
+			},
+			Reset: func() {
+				n.Op = original
+				n.X, n.Y = x, y
+			},
+		},
+	}
+}
+
+// parenthesize encloses the operand in parentheses if it is a binary expression with a lower precedence than the
+// operator.
+func parenthesize(operand ast.Expr, op token.Token) ast.Expr {
+	if b, ok := operand.(*ast.BinaryExpr); ok && b.Op.Precedence() < op.Precedence() {
+		return &ast.ParenExpr{X: operand}
+	}
+	return operand
+}
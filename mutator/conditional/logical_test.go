@@ -0,0 +1,16 @@
+package conditional
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorConditionalLogical(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorConditionalLogical,
+		"../../testdata/conditional/logical.go",
+		4,
+	)
+}
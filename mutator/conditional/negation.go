@@ -0,0 +1,62 @@
+package conditional
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("conditional/negation", MutatorConditionalNegation)
+}
+
+// MutatorConditionalNegation implements a mutator to remove the "!" operator and to negate conditions of if and for
+// statements.
+func MutatorConditionalNegation(_ *types.Package, _ *types.Info, node ast.Node) []mutator.Mutation {
+	var mutations []mutator.Mutation
+
+	for _, ref := range astutil.ChildExpressions(node) {
+		original := *ref
+		if isNegation(original) {
+			u := original.(*ast.UnaryExpr)
+			mutations = append(mutations, mutator.Mutation{
+				Change: func() {
+					*ref = u.X
+				},
+				Reset: func() {
+					*ref = original
+				},
+			})
+		}
+	}
+
+	var cond *ast.Expr
+	switch n := node.(type) {
+	case *ast.IfStmt:
+		cond = &n.Cond
+	case *ast.ForStmt:
+		cond = &n.Cond
+	}
+	// Negated conditions are already mutated by removing the operator.
+	if cond != nil && *cond != nil && !isNegation(*cond) {
+		original := *cond
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				*cond = astutil.CreateNegation(original)
+			},
+			Reset: func() {
+				*cond = original
+			},
+		})
+	}
+
+	return mutations
+}
+
+func isNegation(expr ast.Expr) bool {
+	u, ok := expr.(*ast.UnaryExpr)
+	return ok && u.Op == token.NOT
+}
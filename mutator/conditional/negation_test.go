@@ -0,0 +1,16 @@
+package conditional
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorConditionalNegation(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorConditionalNegation,
+		"../../testdata/conditional/negation.go",
+		6,
+	)
+}
@@ -0,0 +1,63 @@
+package context
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("context/background", MutatorBackground)
+}
+
+// MutatorBackground implements a mutator to replace context arguments of calls with a new background context, e.g.
+// "db.QueryContext(ctx, query)" is replaced by "db.QueryContext(context.Background(), query)". Files without an import
+// of the context package are not mutated.
+func MutatorBackground(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	n, ok := node.(*ast.CallExpr)
+	if !ok {
+		return nil
+	}
+	if tv, ok := info.Types[n.Fun]; !ok || tv.IsType() {
+		return nil
+	}
+	name, ok := astutil.ImportNameByPath(pkg, n.Pos(), "context")
+	if !ok {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	for i, arg := range n.Args {
+		t := info.TypeOf(arg)
+		if !isContext(t) || isBackground(info, arg) || astutil.HasLastUse(info, arg) {
+			continue
+		}
+		mutated := &ast.CallExpr{
+			Fun: &ast.SelectorExpr{X: ast.NewIdent(name), Sel: ast.NewIdent("Background")},
+		}
+		// The package name can be shadowed at the position of the call.
+		if !astutil.Compiles(pkg, n.Pos(), mutated, t) {
+			continue
+		}
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				n.Args[i] = mutated
+			},
+			Reset: func() {
+				n.Args[i] = arg
+			},
+		})
+	}
+
+	return mutations
+}
+
+// isBackground reports whether the expression is a call of context.Background or context.TODO.
+func isBackground(info *types.Info, expr ast.Expr) bool {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	return ok && astutil.IsPackageFunc(info, call, "context", "Background", "TODO")
+}
@@ -0,0 +1,16 @@
+package context
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorBackground(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorBackground,
+		"../../testdata/context/background.go",
+		3,
+	)
+}
@@ -0,0 +1,43 @@
+package context
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("context/cancel", MutatorCancel)
+}
+
+// MutatorCancel implements a mutator to remove deferred calls of context cancel functions, e.g. "defer cancel()".
+// Removed calls are replaced by noop statements, which keep the cancel functions used.
+func MutatorCancel(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	list := astutil.StatementList(node)
+	if list == nil {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	l := *list
+	for i, stmt := range l {
+		d, ok := stmt.(*ast.DeferStmt)
+		if !ok || !isNamed(info.TypeOf(d.Call.Fun), "CancelFunc", "CancelCauseFunc") {
+			continue
+		}
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				l[i] = astutil.CreateNoopOfStatements(pkg, info, stmt)
+			},
+			Reset: func() {
+				l[i] = stmt
+			},
+		})
+	}
+
+	return mutations
+}
@@ -0,0 +1,16 @@
+package context
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorCancel(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorCancel,
+		"../../testdata/context/cancel.go",
+		2,
+	)
+}
@@ -0,0 +1,48 @@
+package context
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+)
+
+// isNamed reports whether the type is one of the named types of the context package.
+func isNamed(t types.Type, names ...string) bool {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	if obj.Pkg() == nil || obj.Pkg().Path() != "context" {
+		return false
+	}
+	for _, name := range names {
+		if obj.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// isContext reports whether the type is exactly context.Context.
+func isContext(t types.Type) bool {
+	return isNamed(t, "Context")
+}
+
+// callsMethod reports whether the nodes contain a call of one of the named methods of context.Context.
+func callsMethod(info *types.Info, names []string, nodes ...ast.Node) bool {
+	found := false
+	for _, node := range nodes {
+		if node == nil {
+			continue
+		}
+		ast.Inspect(node, func(n ast.Node) bool {
+			if call, ok := n.(*ast.CallExpr); ok && astutil.IsMethod(info, call, "context", "Context", names...) {
+				found = true
+			}
+			return !found
+		})
+	}
+	return found
+}
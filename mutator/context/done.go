@@ -0,0 +1,48 @@
+package context
+
+import (
+	"go/ast"
+	"go/types"
+	"slices"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("context/done", MutatorDone)
+}
+
+// MutatorDone implements a mutator to remove cases of select statements receiving from "ctx.Done()", so
+// cancellations are ignored. Cases of select statements with a single case are not removed, as an empty select
+// statement blocks forever.
+func MutatorDone(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	n, ok := node.(*ast.SelectStmt)
+	if !ok || len(n.Body.List) < 2 {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	original := n.Body.List
+	for i, clause := range original {
+		c, ok := clause.(*ast.CommClause)
+		if !ok || c.Comm == nil || !callsMethod(info, []string{"Done"}, c.Comm) {
+			continue
+		}
+		if astutil.HasLastUse(info, clause) {
+			continue
+		}
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				n.Body.List = slices.Delete(slices.Clone(original), i, i+1)
+			},
+			Reset: func() {
+				n.Body.List = original
+			},
+		})
+	}
+
+	return mutations
+}
@@ -0,0 +1,16 @@
+package context
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorDone(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorDone,
+		"../../testdata/context/done.go",
+		1,
+	)
+}
@@ -0,0 +1,53 @@
+package context
+
+import (
+	"go/ast"
+	"go/types"
+	"slices"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("context/err", MutatorErr)
+}
+
+// MutatorErr implements a mutator to remove checks of context errors, i.e. if statements calling "ctx.Err()" in
+// their init statement or condition, e.g.
+//
+//	if err := ctx.Err(); err != nil {
+//		return err
+//	}
+//
+// Checks with an else branch are not removed.
+func MutatorErr(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	list := astutil.StatementList(node)
+	if list == nil {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	for i, stmt := range *list {
+		n, ok := stmt.(*ast.IfStmt)
+		if !ok || n.Else != nil || !callsMethod(info, []string{"Err"}, n.Init, n.Cond) {
+			continue
+		}
+		if astutil.HasLastUse(info, n) {
+			continue
+		}
+
+		original := *list
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				*list = slices.Delete(slices.Clone(original), i, i+1)
+			},
+			Reset: func() {
+				*list = original
+			},
+		})
+	}
+
+	return mutations
+}
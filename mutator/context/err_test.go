@@ -0,0 +1,16 @@
+package context
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorErr(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorErr,
+		"../../testdata/context/err.go",
+		2,
+	)
+}
@@ -0,0 +1,58 @@
+package context
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("context/timeout", MutatorTimeout)
+}
+
+// MutatorTimeout implements a mutator to drop timeouts and deadlines of contexts, so the parent context is passed
+// through, e.g. "ctx, cancel := context.WithTimeout(parent, d)" is replaced by "ctx, cancel := parent, func() {}".
+// Only parents of the context.Context type are passed through, so the types of assigned variables are kept.
+func MutatorTimeout(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	n, ok := node.(*ast.AssignStmt)
+	if !ok || len(n.Lhs) != 2 || len(n.Rhs) != 1 {
+		return nil
+	}
+	call, ok := ast.Unparen(n.Rhs[0]).(*ast.CallExpr)
+	if !ok || len(call.Args) < 2 {
+		return nil
+	}
+	if !astutil.IsPackageFunc(info, call, "context", "WithTimeout", "WithDeadline", "WithTimeoutCause", "WithDeadlineCause") {
+		return nil
+	}
+	parent := call.Args[0]
+	if !isContext(info.TypeOf(parent)) {
+		return nil
+	}
+	var dropped []ast.Node
+	for _, arg := range call.Args[1:] {
+		dropped = append(dropped, arg)
+	}
+	if astutil.HasLastUse(info, dropped...) {
+		return nil
+	}
+
+	original := n.Rhs
+	mutated := []ast.Expr{
+		parent,
+		&ast.FuncLit{
+			Type: &ast.FuncType{Params: &ast.FieldList{}},
+			Body: &ast.BlockStmt{},
+		},
+	}
+	return []mutator.Mutation{{
+		Change: func() {
+			n.Rhs = mutated
+		},
+		Reset: func() {
+			n.Rhs = original
+		},
+	}}
+}
@@ -0,0 +1,16 @@
+package context
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorTimeout(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorTimeout,
+		"../../testdata/context/timeout.go",
+		2,
+	)
+}
@@ -0,0 +1,49 @@
+package errorhandling
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+)
+
+// errorGuard returns the if statement and its checked error if the statement is an error guard, e.g.
+//
+//	if err != nil {
+//		return err
+//	}
+//
+// The checked value has to implement the error interface and the body has to end with a return statement.
+func errorGuard(info *types.Info, node ast.Node) (*ast.IfStmt, ast.Expr, bool) {
+	n, ok := node.(*ast.IfStmt)
+	if !ok {
+		return nil, nil, false
+	}
+	cond, ok := ast.Unparen(n.Cond).(*ast.BinaryExpr)
+	if !ok || cond.Op != token.NEQ {
+		return nil, nil, false
+	}
+
+	var checked ast.Expr
+	switch {
+	case astutil.IsUniverse(info, cond.Y, "nil"):
+		checked = cond.X
+	case astutil.IsUniverse(info, cond.X, "nil"):
+		checked = cond.Y
+	default:
+		return nil, nil, false
+	}
+	if !astutil.ImplementsError(info.TypeOf(checked)) {
+		return nil, nil, false
+	}
+
+	if len(n.Body.List) == 0 {
+		return nil, nil, false
+	}
+	if _, ok := n.Body.List[len(n.Body.List)-1].(*ast.ReturnStmt); !ok {
+		return nil, nil, false
+	}
+
+	return n, checked, true
+}
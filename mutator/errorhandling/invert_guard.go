@@ -0,0 +1,33 @@
+package errorhandling
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("error/invert_guard", MutatorInvertGuard)
+}
+
+// MutatorInvertGuard implements a mutator to invert error guards, e.g. "err != nil" is replaced by "err == nil".
+func MutatorInvertGuard(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	guard, _, ok := errorGuard(info, node)
+	if !ok {
+		return nil
+	}
+	cond := ast.Unparen(guard.Cond).(*ast.BinaryExpr)
+
+	return []mutator.Mutation{
+		{
+			Change: func() {
+				cond.Op = token.EQL
+			},
+			Reset: func() {
+				cond.Op = token.NEQ
+			},
+		},
+	}
+}
@@ -0,0 +1,16 @@
+package errorhandling
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorInvertGuard(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorInvertGuard,
+		"../../testdata/errorhandling/invert_guard.go",
+		2,
+	)
+}
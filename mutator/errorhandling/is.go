@@ -0,0 +1,41 @@
+package errorhandling
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("error/is", MutatorIs)
+}
+
+// MutatorIs implements a mutator to replace calls of errors.Is and errors.As by false.
+func MutatorIs(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	var mutations []mutator.Mutation
+
+	for _, ref := range astutil.ChildExpressions(node) {
+		call, ok := ast.Unparen(*ref).(*ast.CallExpr)
+		if !ok || !astutil.IsPackageFunc(info, call, "errors", "Is", "As") || astutil.HasLastUse(info, call) {
+			continue
+		}
+		mutated := ast.NewIdent("false")
+		if !astutil.Compiles(pkg, call.Pos(), mutated, types.Typ[types.Bool]) {
+			continue
+		}
+
+		original := *ref
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				*ref = mutated
+			},
+			Reset: func() {
+				*ref = original
+			},
+		})
+	}
+
+	return mutations
+}
@@ -0,0 +1,16 @@
+package errorhandling
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorIs(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorIs,
+		"../../testdata/errorhandling/is.go",
+		2,
+	)
+}
@@ -0,0 +1,99 @@
+package errorhandling
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"slices"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("error/remove_guard", MutatorRemoveGuard)
+}
+
+// MutatorRemoveGuard implements a mutator to remove error guards, so errors are ignored. Guards with an else branch are
+// not removed.
+func MutatorRemoveGuard(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	list := astutil.StatementList(node)
+	if list == nil {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	for i, stmt := range *list {
+		guard, checked, ok := errorGuard(info, stmt)
+		if !ok || guard.Else != nil {
+			continue
+		}
+		replacement, ok := guardReplacement(info, guard, checked)
+		if !ok {
+			continue
+		}
+
+		original := *list
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				*list = slices.Concat(original[:i], replacement, original[i+1:])
+			},
+			Reset: func() {
+				*list = original
+			},
+		})
+	}
+
+	return mutations
+}
+
+// guardReplacement returns statements which replace the removed guard. The init statement of the guard is kept, but
+// variables declared by it are discarded. The checked error is discarded too, if the guard is its last use. The last
+// return argument is false if the guard cannot be removed without breaking the compilation.
+func guardReplacement(info *types.Info, guard *ast.IfStmt, checked ast.Expr) ([]ast.Stmt, bool) {
+	var nodes []ast.Node
+	if guard.Init != nil {
+		nodes = append(nodes, guard.Init)
+	}
+	if astutil.HasLastUse(info, append(nodes, guard.Body)...) {
+		return nil, false
+	}
+
+	var stmts []ast.Stmt
+	switch init := guard.Init.(type) {
+	case nil:
+	case *ast.AssignStmt:
+		if init.Tok != token.DEFINE {
+			stmts = append(stmts, init)
+			break
+		}
+		lhs := make([]ast.Expr, len(init.Lhs))
+		for i := range lhs {
+			lhs[i] = ast.NewIdent("_")
+		}
+		stmts = append(stmts, &ast.AssignStmt{Lhs: lhs, Tok: token.ASSIGN, Rhs: init.Rhs})
+	default:
+		stmts = append(stmts, init)
+	}
+
+	if !declaredBy(info, guard.Init, checked) && astutil.HasLastUse(info, guard.Cond, guard.Body) {
+		stmts = append(stmts, &ast.AssignStmt{
+			Lhs: []ast.Expr{ast.NewIdent("_")},
+			Tok: token.ASSIGN,
+			Rhs: []ast.Expr{checked},
+		})
+	}
+
+	return stmts, true
+}
+
+// declaredBy reports whether the expression is a variable declared by the statement.
+func declaredBy(info *types.Info, stmt ast.Stmt, expr ast.Expr) bool {
+	ident, ok := ast.Unparen(expr).(*ast.Ident)
+	if stmt == nil || !ok {
+		return false
+	}
+	obj := info.Uses[ident]
+	return obj != nil && stmt.Pos() <= obj.Pos() && obj.Pos() < stmt.End()
+}
@@ -0,0 +1,16 @@
+package errorhandling
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorRemoveGuard(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorRemoveGuard,
+		"../../testdata/errorhandling/remove_guard.go",
+		3,
+	)
+}
@@ -0,0 +1,73 @@
+package errorhandling
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("error/unwrap", MutatorUnwrap)
+}
+
+// MutatorUnwrap implements a mutator to replace the %w verb of fmt.Errorf format strings by %v, so the wrapped error
+// cannot be unwrapped anymore. Every %w verb is replaced separately.
+func MutatorUnwrap(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	call, ok := node.(*ast.CallExpr)
+	if !ok || len(call.Args) == 0 || !astutil.IsPackageFunc(info, call, "fmt", "Errorf") {
+		return nil
+	}
+	lit, ok := call.Args[0].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	original := lit.Value
+	for _, i := range wrapVerbs(original) {
+		mutated := original[:i] + "v" + original[i+1:]
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				lit.Value = mutated
+			},
+			Reset: func() {
+				lit.Value = original
+			},
+		})
+	}
+
+	return mutations
+}
+
+// wrapVerbs returns offsets of the "w" letters of all %w verbs of a quoted format string. A percent sign is never
+// escaped in Go string literals, so the quoted string can be scanned as is.
+func wrapVerbs(format string) []int {
+	var offsets []int
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+		// Skip flags, width and precision up to the verb.
+		j := i + 1
+		for j < len(format) && isVerbModifier(format[j]) {
+			j++
+		}
+		if j < len(format) && format[j] == 'w' {
+			offsets = append(offsets, j)
+		}
+		i = j
+	}
+	return offsets
+}
+
+func isVerbModifier(c byte) bool {
+	switch c {
+	case '+', '-', '#', ' ', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9', '.', '*':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,16 @@
+package errorhandling
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorUnwrap(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorUnwrap,
+		"../../testdata/errorhandling/unwrap.go",
+		3,
+	)
+}
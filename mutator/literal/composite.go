@@ -0,0 +1,54 @@
+package literal
+
+import (
+	"go/ast"
+	"go/types"
+	"slices"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+// compositeType returns the underlying type of the composite literal. Arrays of literals with an implicit length,
+// i.e. "[...]T{}", are not reported, as the length is part of their type.
+func compositeType(info *types.Info, lit *ast.CompositeLit) types.Type {
+	if a, ok := lit.Type.(*ast.ArrayType); ok {
+		if _, ok := a.Len.(*ast.Ellipsis); ok {
+			return nil
+		}
+	}
+	t := info.TypeOf(lit)
+	if t == nil {
+		return nil
+	}
+	return t.Underlying()
+}
+
+// elementRemovals returns mutations removing single elements of the composite literal. Literals with a single element
+// are emptied by the literal/composite_empty mutator instead. Elements holding the last use of a variable are not
+// removed.
+func elementRemovals(info *types.Info, lit *ast.CompositeLit, skip func(elt ast.Expr) bool) []mutator.Mutation {
+	if len(lit.Elts) < 2 {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	original := lit.Elts
+	for i, elt := range original {
+		if skip(elt) || astutil.HasLastUse(info, elt) {
+			continue
+		}
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				lit.Elts = slices.Delete(slices.Clone(original), i, i+1)
+			},
+			Reset: func() {
+				lit.Elts = original
+			},
+		})
+	}
+
+	return mutations
+}
@@ -0,0 +1,30 @@
+package literal
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("literal/composite_element", MutatorCompositeElement)
+}
+
+// MutatorCompositeElement implements a mutator to remove single elements of map, slice and array literals, e.g.
+// "map[int]bool{1: true, 2: true}" is replaced by "map[int]bool{2: true}".
+func MutatorCompositeElement(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	n, ok := node.(*ast.CompositeLit)
+	if !ok {
+		return nil
+	}
+	switch compositeType(info, n).(type) {
+	case *types.Map, *types.Slice, *types.Array:
+	default:
+		return nil
+	}
+
+	return elementRemovals(info, n, func(ast.Expr) bool {
+		return false
+	})
+}
@@ -0,0 +1,16 @@
+package literal
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorCompositeElement(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorCompositeElement,
+		"../../testdata/literal/composite_element.go",
+		8,
+	)
+}
@@ -0,0 +1,42 @@
+package literal
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("literal/composite_empty", MutatorCompositeEmpty)
+}
+
+// MutatorCompositeEmpty implements a mutator to replace composite literals with empty ones, e.g. "[]int{1, 2}" is
+// replaced by "[]int{}".
+func MutatorCompositeEmpty(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	n, ok := node.(*ast.CompositeLit)
+	if !ok || len(n.Elts) == 0 || compositeType(info, n) == nil {
+		return nil
+	}
+
+	original := n.Elts
+	nodes := make([]ast.Node, len(original))
+	for i, elt := range original {
+		nodes[i] = elt
+	}
+	if astutil.HasLastUse(info, nodes...) {
+		return nil
+	}
+
+	return []mutator.Mutation{
+		{
+			Change: func() {
+				n.Elts = nil
+			},
+			Reset: func() {
+				n.Elts = original
+			},
+		},
+	}
+}
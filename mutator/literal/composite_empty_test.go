@@ -0,0 +1,16 @@
+package literal
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorCompositeEmpty(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorCompositeEmpty,
+		"../../testdata/literal/composite_empty.go",
+		3,
+	)
+}
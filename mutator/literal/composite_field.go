@@ -0,0 +1,33 @@
+package literal
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("literal/composite_field", MutatorCompositeField)
+}
+
+// MutatorCompositeField implements a mutator to zero single fields of keyed struct literals by removing them, e.g.
+// "Config{Port: 80, Debug: true}" is replaced by "Config{Debug: true}". Fields which are zero values already are not
+// removed, and unkeyed struct literals are not mutated, as they require all fields.
+func MutatorCompositeField(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	n, ok := node.(*ast.CompositeLit)
+	if !ok || len(n.Elts) == 0 {
+		return nil
+	}
+	if _, ok := compositeType(info, n).(*types.Struct); !ok {
+		return nil
+	}
+	if _, ok := n.Elts[0].(*ast.KeyValueExpr); !ok {
+		return nil
+	}
+
+	return elementRemovals(info, n, func(elt ast.Expr) bool {
+		return astutil.IsZeroValue(info, elt.(*ast.KeyValueExpr).Value)
+	})
+}
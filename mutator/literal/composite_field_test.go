@@ -0,0 +1,16 @@
+package literal
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorCompositeField(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorCompositeField,
+		"../../testdata/literal/composite_field.go",
+		4,
+	)
+}
@@ -0,0 +1,158 @@
+package literal
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"sync"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+// childLiteral is a literal which is a direct child of a node.
+type childLiteral struct {
+	ref *ast.Expr
+	lit *ast.BasicLit
+	// taken holds values the literal must not be changed to, e.g. values of other cases of a switch statement.
+	taken []constant.Value
+}
+
+// childLiterals returns literals of the given kind which are direct children of the node. Case values of switch
+// statements and keys of map literals are returned for the switch statements and map literals, so replacements can be
+// checked against duplicates. Operands of indexing and slicing, arguments of builtins such as len and literals of
+// constant declarations are not returned, as they can be checked against constant lengths, e.g. of array types.
+func childLiterals(info *types.Info, node ast.Node, kind token.Token) []childLiteral {
+	var refs []*ast.Expr
+	var taken []constant.Value
+
+	switch n := node.(type) {
+	case *ast.CaseClause:
+		return nil
+	case *ast.SwitchStmt:
+		for _, stmt := range n.Body.List {
+			clause := stmt.(*ast.CaseClause)
+			for i := range clause.List {
+				refs = append(refs, &clause.List[i])
+			}
+		}
+		taken = constantValues(info, refs)
+	case *ast.CompositeLit:
+		if _, ok := info.TypeOf(n).Underlying().(*types.Map); ok {
+			for _, elt := range n.Elts {
+				if kv, ok := elt.(*ast.KeyValueExpr); ok {
+					refs = append(refs, &kv.Key)
+				}
+			}
+			taken = constantValues(info, refs)
+		}
+		refs = append(refs, astutil.ChildExpressions(n)...)
+	case *ast.IndexExpr, *ast.SliceExpr:
+		return nil
+	case *ast.CallExpr:
+		if _, ok := info.Uses[identOf(n.Fun)].(*types.Builtin); ok {
+			return nil
+		}
+		refs = astutil.ChildExpressions(n)
+	default:
+		refs = astutil.ChildExpressions(n)
+	}
+
+	declared := constLiterals(info)
+	var literals []childLiteral
+	for _, ref := range refs {
+		if lit, ok := (*ref).(*ast.BasicLit); ok && lit.Kind == kind && !declared[lit] {
+			literals = append(literals, childLiteral{ref: ref, lit: lit, taken: taken})
+		}
+	}
+	return literals
+}
+
+// constLiterals returns the literals of constant declarations. The files are taken from the file versions of the info,
+// which are recorded by the package loader. The result of the last info is cached, as mutators check many nodes of the
+// same package.
+func constLiterals(info *types.Info) map[*ast.BasicLit]bool {
+	constCache.Lock()
+	defer constCache.Unlock()
+	if constCache.info == info {
+		return constCache.literals
+	}
+
+	literals := make(map[*ast.BasicLit]bool)
+	for file := range info.FileVersions {
+		ast.Inspect(file, func(node ast.Node) bool {
+			decl, ok := node.(*ast.GenDecl)
+			if !ok || decl.Tok != token.CONST {
+				return true
+			}
+			ast.Inspect(decl, func(node ast.Node) bool {
+				if lit, ok := node.(*ast.BasicLit); ok {
+					literals[lit] = true
+				}
+				return true
+			})
+			return false
+		})
+	}
+
+	constCache.info, constCache.literals = info, literals
+	return literals
+}
+
+var constCache struct {
+	sync.Mutex
+	info     *types.Info
+	literals map[*ast.BasicLit]bool
+}
+
+func constantValues(info *types.Info, refs []*ast.Expr) []constant.Value {
+	var values []constant.Value
+	for _, ref := range refs {
+		if tv, ok := info.Types[*ref]; ok && tv.Value != nil {
+			values = append(values, tv.Value)
+		}
+	}
+	return values
+}
+
+func identOf(expr ast.Expr) *ast.Ident {
+	ident, _ := ast.Unparen(expr).(*ast.Ident)
+	return ident
+}
+
+// replacements returns mutations replacing the literal with the given literal values. Values equal to the original or
+// to a taken value are skipped.
+func (l childLiteral) replacements(values ...string) []mutator.Mutation {
+	var mutations []mutator.Mutation
+
+	original := constant.MakeFromLiteral(l.lit.Value, l.lit.Kind, 0)
+	for _, value := range values {
+		v := constant.MakeFromLiteral(value, l.lit.Kind, 0)
+		if constant.Compare(v, token.EQL, original) || isTaken(v, l.taken) {
+			continue
+		}
+
+		ref, lit := l.ref, l.lit
+		mutated := &ast.BasicLit{Kind: l.lit.Kind, Value: value}
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				*ref = mutated
+			},
+			Reset: func() {
+				*ref = lit
+			},
+		})
+	}
+
+	return mutations
+}
+
+func isTaken(v constant.Value, taken []constant.Value) bool {
+	for _, t := range taken {
+		if t.Kind() == v.Kind() && constant.Compare(t, token.EQL, v) {
+			return true
+		}
+	}
+	return false
+}
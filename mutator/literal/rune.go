@@ -0,0 +1,29 @@
+package literal
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("literal/rune", MutatorRune)
+}
+
+// MutatorRune implements a mutator to replace rune literals with a zero rune and a marker rune. Literals of constant
+// expressions and declarations are not mutated, as the changed value can overflow, e.g. in 'a' + 200.
+func MutatorRune(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if expr, ok := node.(ast.Expr); ok {
+		if tv, ok := info.Types[expr]; ok && tv.Value != nil {
+			return nil
+		}
+	}
+
+	var mutations []mutator.Mutation
+	for _, l := range childLiterals(info, node, token.CHAR) {
+		mutations = append(mutations, l.replacements(`'\x00'`, `'?'`)...)
+	}
+	return mutations
+}
@@ -0,0 +1,16 @@
+package literal
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorRune(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorRune,
+		"../../testdata/literal/rune.go",
+		8,
+	)
+}
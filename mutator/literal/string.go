@@ -0,0 +1,85 @@
+package literal
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("literal/string", MutatorString)
+	mutator.Register("literal/string_log", MutatorStringLog)
+}
+
+// stringMarker is the value string literals are replaced with besides the empty string.
+const stringMarker = "mutant"
+
+// MutatorString implements a mutator to replace string literals with an empty string and a marker string. Literals
+// of logging calls and fmt.Errorf formats are mutated by [MutatorStringLog] instead. Literals of constant declarations
+// are not mutated, as constant expressions using them, e.g. constant indexes, can fail to compile.
+func MutatorString(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if isLogCall(info, node) {
+		return nil
+	}
+	return stringMutations(info, node)
+}
+
+// MutatorStringLog implements a mutator to replace string literals of logging calls and fmt.Errorf formats with an
+// empty string and a marker string. Logging calls are calls of functions and methods of the log and log/slog packages.
+func MutatorStringLog(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	if !isLogCall(info, node) {
+		return nil
+	}
+	return stringMutations(info, node)
+}
+
+func stringMutations(info *types.Info, node ast.Node) []mutator.Mutation {
+	var mutations []mutator.Mutation
+	for _, l := range childLiterals(info, node, token.STRING) {
+		if isFormatWithArgs(info, node, l.ref) {
+			// Formats without verbs are reported by go vet, which fails the tests of the mutant.
+			continue
+		}
+		mutations = append(mutations, l.replacements(`""`, strconv.Quote(stringMarker))...)
+	}
+	return mutations
+}
+
+// isFormatWithArgs reports whether the expression is a format argument of a printf-like call with arguments to format.
+// Functions are considered printf-like if they have a "format" parameter followed by variadic arguments.
+func isFormatWithArgs(info *types.Info, node ast.Node, ref *ast.Expr) bool {
+	call, ok := node.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	sig, ok := info.TypeOf(call.Fun).Underlying().(*types.Signature)
+	if !ok || !sig.Variadic() || sig.Params().Len() < 2 {
+		return false
+	}
+
+	index := sig.Params().Len() - 2
+	if index >= len(call.Args)-1 || ref != &call.Args[index] {
+		return false
+	}
+	return sig.Params().At(index).Name() == "format"
+}
+
+// isLogCall reports whether the node is a call of the log or log/slog packages or of fmt.Errorf.
+func isLogCall(info *types.Info, node ast.Node) bool {
+	call, ok := node.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	if astutil.IsPackageFunc(info, call, "fmt", "Errorf") {
+		return true
+	}
+	fn, ok := astutil.CalledFunc(info, call)
+	if !ok || fn.Pkg() == nil {
+		return false
+	}
+	return fn.Pkg().Path() == "log" || fn.Pkg().Path() == "log/slog"
+}
@@ -0,0 +1,16 @@
+package literal
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorStringLog(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorStringLog,
+		"../../testdata/literal/string_log.go",
+		4,
+	)
+}
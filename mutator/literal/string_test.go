@@ -0,0 +1,16 @@
+package literal
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorString(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorString,
+		"../../testdata/literal/string.go",
+		10,
+	)
+}
@@ -1,9 +1,16 @@
 package mutator
 
+import (
+	"go/ast"
+)
+
 // Mutation defines the behavior of one mutation
 type Mutation struct {
 	// Change is called before executing the exec command.
 	Change func()
 	// Reset is called after executing the exec command.
 	Reset func()
+	// Node is the node the mutation is reported at, e.g. the statement a new statement is inserted before. The node
+	// the mutation was produced for is reported if it is nil.
+	Node ast.Node
 }
@@ -0,0 +1,265 @@
+package numbers
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("numbers/boundary", MutatorNumbersBoundary)
+}
+
+// MutatorNumbersBoundary implements a mutator to replace integer and float literals with 0, 1, -1 and their negation.
+// Replacements keep the base of integer literals. Literals of constant declarations and of constant expressions are
+// not mutated, as their values can be used in contexts such as array lengths, where changed values do not compile.
+func MutatorNumbersBoundary(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	switch n := node.(type) {
+	case *ast.CaseClause:
+		// Changed values can duplicate other cases.
+		return nil
+	case *ast.ValueSpec:
+		if len(n.Names) > 0 {
+			if _, ok := info.Defs[n.Names[0]].(*types.Const); ok {
+				return nil
+			}
+		}
+	case ast.Expr:
+		// Negative literals are constant expressions, which are handled by the context rules.
+		if u, ok := n.(*ast.UnaryExpr); ok && u.Op == token.SUB {
+			break
+		}
+		if tv, ok := info.Types[n]; ok && tv.Value != nil {
+			return nil
+		}
+	}
+
+	var mutations []mutator.Mutation
+
+	for _, child := range astutil.ChildExpressions(node) {
+		// Parenthesized literals are constant expressions, so they are mutated in the context of the parentheses,
+		// e.g. as divisor of "x / (4)".
+		ref := child
+		for {
+			p, ok := (*ref).(*ast.ParenExpr)
+			if !ok {
+				break
+			}
+			ref = &p.X
+		}
+		lit, ok := (*ref).(*ast.BasicLit)
+		if !ok || (lit.Kind != token.INT && lit.Kind != token.FLOAT) {
+			continue
+		}
+		c, ok := boundaryContext(info, node, child)
+		if !ok {
+			continue
+		}
+
+		value := constant.MakeFromLiteral(lit.Value, lit.Kind, 0)
+		if value.Kind() == constant.Unknown {
+			continue
+		}
+
+		for _, replacement := range boundaryValues(value) {
+			if constant.Compare(replacement, token.EQL, value) ||
+				(c.nonNegative && constant.Sign(replacement) < 0) ||
+				(c.nonZero && constant.Sign(replacement) == 0) {
+				continue
+			}
+
+			original := *ref
+			mutated := formatNumber(lit, replacement)
+			mutations = append(mutations, mutator.Mutation{
+				Change: func() {
+					*ref = mutated
+				},
+				Reset: func() {
+					*ref = original
+				},
+			})
+		}
+	}
+
+	return mutations
+}
+
+// boundaryConstraint restricts replacements of a literal.
+type boundaryConstraint struct {
+	nonNegative bool
+	nonZero     bool
+}
+
+// boundaryContext returns constraints of the replacements of the literal with the given parent, which are required
+// for the mutant to compile. The last return argument is false if the literal must not be mutated at all.
+func boundaryContext(info *types.Info, parent ast.Node, ref *ast.Expr) (boundaryConstraint, bool) {
+	var (
+		c          boundaryConstraint
+		typ        = info.TypeOf(*ref)
+		shiftCount bool
+	)
+
+	switch n := parent.(type) {
+	case *ast.UnaryExpr:
+		// The literal is negated already, so only non-negative values are used. Zero is excluded, because the
+		// negative literal can be a divisor. The operand of a negation stays untyped, the negation is typed.
+		c.nonNegative, c.nonZero = true, true
+		typ = info.TypeOf(n)
+	case *ast.BinaryExpr:
+		switch {
+		case ref != &n.Y:
+		case n.Op == token.SHL || n.Op == token.SHR:
+			c.nonNegative, shiftCount = true, true
+		case n.Op == token.QUO || n.Op == token.REM:
+			c.nonZero = true
+		}
+	case *ast.AssignStmt:
+		switch n.Tok {
+		case token.SHL_ASSIGN, token.SHR_ASSIGN:
+			c.nonNegative, shiftCount = true, true
+		case token.QUO_ASSIGN, token.REM_ASSIGN:
+			c.nonZero = true
+		}
+	case *ast.IndexExpr:
+		if ref != &n.Index {
+			break
+		}
+		switch indexedType(info, n.X) {
+		case indexedMap:
+		case indexedSlice:
+			c.nonNegative = true
+		default:
+			// Constant indexes of arrays and constant strings are checked against their lengths.
+			return c, false
+		}
+	case *ast.SliceExpr:
+		if ref == &n.X || indexedType(info, n.X) != indexedSlice {
+			return c, false
+		}
+		// Constant indexes are checked against each other.
+		for _, index := range []ast.Expr{n.Low, n.High, n.Max} {
+			if index == nil || index == *ref {
+				continue
+			}
+			if tv, ok := info.Types[index]; ok && tv.Value != nil {
+				return c, false
+			}
+		}
+		c.nonNegative = true
+	case *ast.CallExpr:
+		// Constant arguments of builtins such as make are checked against each other.
+		if _, ok := info.Uses[astIdent(n.Fun)].(*types.Builtin); ok {
+			return c, false
+		}
+	}
+
+	// Untyped literals are left in constant contexts, only shift counts are untyped in any context.
+	basic, ok := typ.Underlying().(*types.Basic)
+	if !ok || (basic.Info()&types.IsUntyped != 0 && !shiftCount) {
+		return c, false
+	}
+	if basic.Info()&types.IsUnsigned != 0 {
+		c.nonNegative = true
+	}
+
+	return c, true
+}
+
+type indexed int
+
+const (
+	indexedOther indexed = iota
+	indexedMap
+	indexedSlice
+)
+
+// indexedType returns the kind of the indexed expression. Non-constant strings are reported as slices.
+func indexedType(info *types.Info, x ast.Expr) indexed {
+	tv, ok := info.Types[x]
+	if !ok || tv.Value != nil {
+		return indexedOther
+	}
+	switch t := tv.Type.Underlying().(type) {
+	case *types.Map:
+		return indexedMap
+	case *types.Slice:
+		return indexedSlice
+	case *types.Basic:
+		if t.Info()&types.IsString != 0 {
+			return indexedSlice
+		}
+	}
+	return indexedOther
+}
+
+func astIdent(expr ast.Expr) *ast.Ident {
+	ident, _ := ast.Unparen(expr).(*ast.Ident)
+	return ident
+}
+
+// boundaryValues returns the replacement values of the value.
+func boundaryValues(value constant.Value) []constant.Value {
+	values := []constant.Value{
+		constant.MakeInt64(0),
+		constant.MakeInt64(1),
+		constant.MakeInt64(-1),
+	}
+	negated := constant.UnaryOp(token.SUB, value, 0)
+	for _, v := range values {
+		if constant.Compare(v, token.EQL, negated) {
+			return values
+		}
+	}
+	return append(values, negated)
+}
+
+// formatNumber creates an expression of the value in the format of the literal. Negative values are created as
+// negation of a literal.
+func formatNumber(lit *ast.BasicLit, value constant.Value) ast.Expr {
+	negative := constant.Sign(value) < 0
+	if negative {
+		value = constant.UnaryOp(token.SUB, value, 0)
+	}
+
+	var formatted *ast.BasicLit
+	if constant.Compare(value, token.EQL, constant.MakeFromLiteral(lit.Value, lit.Kind, 0)) {
+		formatted = lit
+	} else {
+		formatted = &ast.BasicLit{Kind: lit.Kind, Value: formatValue(lit, value)}
+	}
+
+	if negative {
+		return &ast.UnaryExpr{Op: token.SUB, X: formatted}
+	}
+	return formatted
+}
+
+// formatValue formats the small integral value in the format of the literal, i.e. with the same base of an integer
+// literal or as a float literal.
+func formatValue(lit *ast.BasicLit, value constant.Value) string {
+	n, _ := constant.Int64Val(constant.ToInt(value))
+
+	if lit.Kind == token.FLOAT {
+		return strconv.FormatInt(n, 10) + ".0"
+	}
+
+	lower := strings.ToLower(lit.Value)
+	switch {
+	case strings.HasPrefix(lower, "0x"):
+		return lit.Value[:2] + strconv.FormatInt(n, 16)
+	case strings.HasPrefix(lower, "0b"):
+		return lit.Value[:2] + strconv.FormatInt(n, 2)
+	case strings.HasPrefix(lower, "0o"):
+		return lit.Value[:2] + strconv.FormatInt(n, 8)
+	case len(lit.Value) > 1 && lit.Value[0] == '0' && n != 0:
+		// Legacy octal literal.
+		return "0" + strconv.FormatInt(n, 8)
+	}
+	return strconv.FormatInt(n, 10)
+}
@@ -0,0 +1,16 @@
+package numbers
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorNumbersBoundary(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorNumbersBoundary,
+		"../../testdata/numbers/boundary.go",
+		22,
+	)
+}
@@ -0,0 +1,52 @@
+package returns
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("return/bool", MutatorReturnBool)
+}
+
+// MutatorReturnBool implements a mutator to swap returned booleans: true and false literals are swapped, other
+// expressions are negated.
+func MutatorReturnBool(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	var mutations []mutator.Mutation
+
+	for _, r := range results(info, node) {
+		if !isBoolean(r.typ) {
+			continue
+		}
+
+		original := r.ret.Results[r.index]
+		var mutated ast.Expr
+		switch {
+		case astutil.IsUniverse(info, original, "true"):
+			mutated = ast.NewIdent("false")
+		case astutil.IsUniverse(info, original, "false"):
+			mutated = ast.NewIdent("true")
+		default:
+			mutated = astutil.CreateNegation(original)
+		}
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				r.ret.Results[r.index] = mutated
+			},
+			Reset: func() {
+				r.ret.Results[r.index] = original
+			},
+		})
+	}
+
+	return mutations
+}
+
+func isBoolean(t types.Type) bool {
+	b, ok := t.Underlying().(*types.Basic)
+	return ok && b.Info()&types.IsBoolean != 0
+}
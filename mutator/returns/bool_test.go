@@ -0,0 +1,16 @@
+package returns
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorReturnBool(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorReturnBool,
+		"../../testdata/returns/bool.go",
+		5,
+	)
+}
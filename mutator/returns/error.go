@@ -0,0 +1,73 @@
+package returns
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("return/error", MutatorReturnError)
+}
+
+// mutantErrorMessage is the message of errors returned by mutants.
+const mutantErrorMessage = "mutant"
+
+// MutatorReturnError implements a mutator to return a sentinel error instead of nil from functions returning (T,
+// error). The sentinel is created with errors.New or fmt.Errorf, whichever is imported by the file.
+func MutatorReturnError(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	var mutations []mutator.Mutation
+
+	for _, r := range results(info, node) {
+		n := r.sig.Results().Len()
+		if n < 2 || r.index != n-1 || !astutil.IsErrorType(r.typ) {
+			continue
+		}
+
+		original := r.ret.Results[r.index]
+		if !astutil.IsUniverse(info, original, "nil") {
+			continue
+		}
+
+		mutated, ok := createMutantError(pkg, r.ret.Pos())
+		if !ok {
+			continue
+		}
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				r.ret.Results[r.index] = mutated
+			},
+			Reset: func() {
+				r.ret.Results[r.index] = original
+			},
+		})
+	}
+
+	return mutations
+}
+
+// createMutantError creates a call creating a new error.
+func createMutantError(pkg *types.Package, pos token.Pos) (ast.Expr, bool) {
+	for _, fn := range []struct{ path, name string }{
+		{"errors", "New"},
+		{"fmt", "Errorf"},
+	} {
+		name, ok := astutil.ImportNameByPath(pkg, pos, fn.path)
+		if !ok {
+			continue
+		}
+		expr := &ast.CallExpr{
+			Fun:  &ast.SelectorExpr{X: ast.NewIdent(name), Sel: ast.NewIdent(fn.name)},
+			Args: []ast.Expr{&ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(mutantErrorMessage)}},
+		}
+		if astutil.Compiles(pkg, pos, expr, nil) {
+			return expr, true
+		}
+	}
+	return nil, false
+}
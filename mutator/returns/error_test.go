@@ -0,0 +1,16 @@
+package returns
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorReturnError(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorReturnError,
+		"../../testdata/returns/error.go",
+		1,
+	)
+}
@@ -0,0 +1,36 @@
+package returns
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("return/nil_error", MutatorReturnNilError)
+}
+
+// MutatorReturnNilError implements a mutator to return nil instead of a non-nil error.
+func MutatorReturnNilError(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	var mutations []mutator.Mutation
+
+	for _, r := range results(info, node) {
+		original := r.ret.Results[r.index]
+		if !isErrorInterface(r.typ) || astutil.IsUniverse(info, original, "nil") || astutil.HasLastUse(info, original) {
+			continue
+		}
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				r.ret.Results[r.index] = ast.NewIdent("nil")
+			},
+			Reset: func() {
+				r.ret.Results[r.index] = original
+			},
+		})
+	}
+
+	return mutations
+}
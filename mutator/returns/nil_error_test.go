@@ -0,0 +1,16 @@
+package returns
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorReturnNilError(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorReturnNilError,
+		"../../testdata/returns/nil_error.go",
+		3,
+	)
+}
@@ -0,0 +1,36 @@
+package returns
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+)
+
+// result is a result expression of a return statement together with the declared result type.
+type result struct {
+	ret   *ast.ReturnStmt
+	index int
+	typ   types.Type
+	sig   *types.Signature
+}
+
+// results returns all result expressions of return statements of a function declaration or a function literal.
+// Naked returns and returns of a multi-value call are ignored.
+func results(info *types.Info, node ast.Node) []result {
+	sig, body, ok := astutil.FuncSignature(info, node)
+	if !ok || sig.Results().Len() == 0 {
+		return nil
+	}
+
+	var rs []result
+	for _, ret := range astutil.ReturnStatements(body) {
+		if len(ret.Results) != sig.Results().Len() {
+			continue
+		}
+		for i := range ret.Results {
+			rs = append(rs, result{ret: ret, index: i, typ: sig.Results().At(i).Type(), sig: sig})
+		}
+	}
+	return rs
+}
@@ -0,0 +1,47 @@
+package returns
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("return/zero", MutatorReturnZero)
+}
+
+// MutatorReturnZero implements a mutator to replace returned expressions with the zero value of the result type.
+// Error results are left to [MutatorReturnNilError].
+func MutatorReturnZero(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	var mutations []mutator.Mutation
+
+	for _, r := range results(info, node) {
+		original := r.ret.Results[r.index]
+		if astutil.IsZeroValue(info, original) || isErrorInterface(r.typ) || astutil.HasLastUse(info, original) {
+			continue
+		}
+
+		mutated, ok := astutil.CreateZeroValue(pkg, r.ret.Pos(), r.typ)
+		if !ok {
+			continue
+		}
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				r.ret.Results[r.index] = mutated
+			},
+			Reset: func() {
+				r.ret.Results[r.index] = original
+			},
+		})
+	}
+
+	return mutations
+}
+
+// isErrorInterface checks if the type is an interface implementing error, so nil can be returned.
+func isErrorInterface(t types.Type) bool {
+	return types.IsInterface(t) && astutil.ImplementsError(t)
+}
@@ -0,0 +1,16 @@
+package returns
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorReturnZero(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorReturnZero,
+		"../../testdata/returns/zero.go",
+		9,
+	)
+}
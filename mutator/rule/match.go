@@ -0,0 +1,211 @@
+package rule
+
+import (
+	"bytes"
+	"go/ast"
+	"go/printer"
+	"go/token"
+	"go/types"
+	"reflect"
+)
+
+var (
+	identType    = reflect.TypeFor[*ast.Ident]()
+	selectorType = reflect.TypeFor[*ast.SelectorExpr]()
+	exprType     = reflect.TypeFor[ast.Expr]()
+	posType      = reflect.TypeFor[token.Pos]()
+	objectType   = reflect.TypeFor[*ast.Object]()
+	commentsType = reflect.TypeFor[*ast.CommentGroup]()
+)
+
+// matcher matches patterns against expressions and binds metavariables to the matched expressions. Other identifiers
+// of the pattern are recorded with all identifiers they matched.
+type matcher struct {
+	info     *types.Info
+	vars     map[string]string
+	bindings map[string]ast.Expr
+	idents   map[string][]*ast.Ident
+}
+
+// matches reports whether the expression matches the pattern.
+func (m *matcher) matches(pattern, expr ast.Expr) bool {
+	return m.match(reflect.ValueOf(pattern), reflect.ValueOf(expr))
+}
+
+func (m *matcher) match(pattern, val reflect.Value) bool {
+	if pattern.Kind() == reflect.Interface {
+		pattern = pattern.Elem()
+	}
+	if val.Kind() == reflect.Interface {
+		val = val.Elem()
+	}
+	if !pattern.IsValid() || !val.IsValid() {
+		return pattern.IsValid() == val.IsValid()
+	}
+
+	if pattern.Type() == identType {
+		ident := pattern.Interface().(*ast.Ident)
+		if typ, ok := m.vars[ident.Name]; ok {
+			return m.bind(ident.Name, typ, val)
+		}
+		if val.Type() == identType {
+			return m.matchIdent(ident, val.Interface().(*ast.Ident))
+		}
+	}
+	if pattern.Type() != val.Type() {
+		return false
+	}
+
+	switch pattern.Type() {
+	case posType, objectType, commentsType:
+		return true
+	case selectorType:
+		// Names of selectors are matched syntactically.
+		p, v := pattern.Interface().(*ast.SelectorExpr), val.Interface().(*ast.SelectorExpr)
+		return p.Sel.Name == v.Sel.Name && m.match(reflect.ValueOf(p.X), reflect.ValueOf(v.X))
+	}
+
+	switch pattern.Kind() {
+	case reflect.Pointer:
+		if pattern.IsNil() || val.IsNil() {
+			return pattern.IsNil() == val.IsNil()
+		}
+		return m.match(pattern.Elem(), val.Elem())
+	case reflect.Slice:
+		if pattern.Len() != val.Len() {
+			return false
+		}
+		for i := range pattern.Len() {
+			if !m.match(pattern.Index(i), val.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Struct:
+		for i := range pattern.NumField() {
+			if !m.match(pattern.Field(i), val.Field(i)) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return pattern.Interface() == val.Interface()
+}
+
+// matchIdent reports whether the identifier matches the identifier of the pattern, which names an imported package by
+// its package name or a predeclared object, e.g. "strings" matches the renamed import "str", but no local variable
+// named "strings". Identifiers without a used object, e.g. names of declarations, are matched syntactically.
+func (m *matcher) matchIdent(pattern, ident *ast.Ident) bool {
+	switch obj := m.info.Uses[ident].(type) {
+	case nil:
+		if ident.Name != pattern.Name {
+			return false
+		}
+	case *types.PkgName:
+		if obj.Imported().Name() != pattern.Name {
+			return false
+		}
+	default:
+		if obj.Parent() != types.Universe || obj.Name() != pattern.Name {
+			return false
+		}
+	}
+	m.idents[pattern.Name] = append(m.idents[pattern.Name], ident)
+	return true
+}
+
+// bind binds the metavariable to the value if the value is an expression of the type of the metavariable. Repeated
+// metavariables need to match the same expression.
+func (m *matcher) bind(name, typ string, val reflect.Value) bool {
+	expr, ok := val.Interface().(ast.Expr)
+	if !ok {
+		return false
+	}
+	tv, ok := m.info.Types[expr]
+	if !ok || !tv.IsValue() || tv.Type == nil {
+		return false
+	}
+	if typ != "any" && types.TypeString(tv.Type, (*types.Package).Name) != typ {
+		return false
+	}
+
+	if bound, ok := m.bindings[name]; ok {
+		return source(bound) == source(expr)
+	}
+	m.bindings[name] = expr
+	return true
+}
+
+// substitute replaces the metavariables of the parsed replacement with their bound expressions and renames other
+// identifiers to the identifiers matched by the pattern, e.g. "strings" to a renamed import. Positions of the
+// replacement are cleared, as they do not belong to the mutated file.
+func (m *matcher) substitute(replacement ast.Expr) ast.Expr {
+	if ident, ok := replacement.(*ast.Ident); ok {
+		if bound, ok := m.bindings[ident.Name]; ok {
+			return bound
+		}
+		if matched, ok := m.idents[ident.Name]; ok {
+			return &ast.Ident{Name: matched[0].Name}
+		}
+	}
+	m.replace(reflect.ValueOf(replacement))
+	return replacement
+}
+
+func (m *matcher) replace(val reflect.Value) {
+	switch val.Kind() {
+	case reflect.Interface, reflect.Pointer:
+		if !val.IsNil() {
+			m.replace(val.Elem())
+		}
+	case reflect.Slice:
+		for i := range val.Len() {
+			m.replaceField(val.Index(i))
+		}
+	case reflect.Struct:
+		// Names of selectors are not metavariables.
+		if val.Type() == selectorType.Elem() {
+			m.replaceField(val.FieldByName("X"))
+			m.replace(val.FieldByName("Sel"))
+			return
+		}
+		for i := range val.NumField() {
+			m.replaceField(val.Field(i))
+		}
+	}
+}
+
+// replaceField replaces the value of the field with the bound expression if the field is an expression naming a
+// metavariable, and renames identifiers matched by the pattern. Other fields are traversed and their positions are
+// cleared.
+func (m *matcher) replaceField(field reflect.Value) {
+	switch field.Type() {
+	case posType:
+		field.SetInt(int64(token.NoPos))
+		return
+	case objectType:
+		return
+	case exprType:
+		if ident, ok := field.Interface().(*ast.Ident); ok {
+			if bound, ok := m.bindings[ident.Name]; ok {
+				field.Set(reflect.ValueOf(bound))
+				return
+			}
+			if matched, ok := m.idents[ident.Name]; ok {
+				field.Set(reflect.ValueOf(&ast.Ident{Name: matched[0].Name}))
+				return
+			}
+		}
+	}
+	m.replace(field)
+}
+
+// source returns the source code of the expression.
+func source(expr ast.Expr) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, token.NewFileSet(), expr); err != nil {
+		return ""
+	}
+	return buf.String()
+}
@@ -0,0 +1,201 @@
+// Package rule implements custom mutators declared as pattern and replacement rules over Go expressions, e.g.
+//
+//	name: equal_fold
+//	pattern: strings.EqualFold(a, b)
+//	replacement: a == b
+//	vars:
+//	  a: string
+//	  b: string
+//
+// Identifiers of the pattern which are declared as variables are metavariables. A metavariable matches any value
+// expression of its type, which is written as printed by go/types with package names, e.g. "int", "[]byte" or
+// "time.Duration", while "any" matches expressions of any type. Other identifiers of the pattern name imported packages
+// by their package name or predeclared objects and are resolved by the type checker, so renamed imports are matched,
+// but shadowing variables are not. All other parts of the pattern are matched syntactically, like the patterns of
+// "gofmt -r".
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"slices"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+// Prefix is the prefix of the names of registered rule mutators.
+const Prefix = "rule/"
+
+// Rule is a declarative mutator replacing expressions matching the pattern with the replacement.
+type Rule struct {
+	Name        string            `yaml:"name"`
+	Pattern     string            `yaml:"pattern"`
+	Replacement string            `yaml:"replacement"`
+	Vars        map[string]string `yaml:"vars"`
+}
+
+// Register compiles the rules and registers them as mutators named by the rule names with the "rule/" prefix.
+func Register(rules ...Rule) error {
+	for _, r := range rules {
+		name := Prefix + r.Name
+		if slices.Contains(mutator.List(), name) {
+			return fmt.Errorf("mutator %q already registered", name)
+		}
+		m, err := Compile(r)
+		if err != nil {
+			return err
+		}
+		mutator.Register(name, m)
+	}
+	return nil
+}
+
+// Compile compiles the rule into a mutator.
+func Compile(r Rule) (mutator.Mutator, error) {
+	if !token.IsIdentifier(r.Name) {
+		return nil, fmt.Errorf("invalid rule name %q", r.Name)
+	}
+	pattern, err := parser.ParseExpr(r.Pattern)
+	if err != nil {
+		return nil, fmt.Errorf("parse pattern of rule %q: %w", r.Name, err)
+	}
+	replacement, err := parser.ParseExpr(r.Replacement)
+	if err != nil {
+		return nil, fmt.Errorf("parse replacement of rule %q: %w", r.Name, err)
+	}
+	patternVars := r.metavariables(pattern)
+	for name, typ := range r.Vars {
+		if typ == "" {
+			return nil, fmt.Errorf("variable %q of rule %q has no type", name, r.Name)
+		}
+		if !slices.Contains(patternVars, name) {
+			return nil, fmt.Errorf("variable %q of rule %q is not used by the pattern", name, r.Name)
+		}
+	}
+
+	// Identifiers of the pattern which are dropped by the replacement can be or contain last uses of variables and
+	// imported packages.
+	var kept, dropped []string
+	for _, ident := range values(replacement) {
+		kept = append(kept, ident.Name)
+	}
+	for _, ident := range values(pattern) {
+		if !slices.Contains(kept, ident.Name) && !slices.Contains(dropped, ident.Name) {
+			dropped = append(dropped, ident.Name)
+		}
+	}
+
+	return func(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+		// Changed values can duplicate other cases.
+		if _, ok := node.(*ast.CaseClause); ok {
+			return nil
+		}
+
+		var mutations []mutator.Mutation
+
+		for _, ref := range expressions(node) {
+			m := &matcher{
+				info:     info,
+				vars:     r.Vars,
+				bindings: make(map[string]ast.Expr),
+				idents:   make(map[string][]*ast.Ident),
+			}
+			if !m.matches(pattern, *ref) {
+				continue
+			}
+			var removed []ast.Node
+			for _, name := range dropped {
+				if bound, ok := m.bindings[name]; ok {
+					removed = append(removed, bound)
+				}
+				for _, ident := range m.idents[name] {
+					removed = append(removed, ident)
+				}
+			}
+			if astutil.HasLastUse(info, removed...) {
+				continue
+			}
+			// The replacement is parsed again, so every mutation has its own nodes.
+			parsed, err := parser.ParseExpr(r.Replacement)
+			if err != nil {
+				continue
+			}
+			mutated := m.substitute(parsed)
+			if _, ok := node.(*ast.ExprStmt); ok {
+				if _, ok := mutated.(*ast.CallExpr); !ok {
+					continue
+				}
+			}
+			if !astutil.Compiles(pkg, (*ref).Pos(), mutated, info.TypeOf(*ref)) {
+				continue
+			}
+
+			original := *ref
+			mutations = append(mutations, mutator.Mutation{
+				Change: func() {
+					*ref = mutated
+				},
+				Reset: func() {
+					*ref = original
+				},
+			})
+		}
+
+		return mutations
+	}, nil
+}
+
+// expressions returns pointers to the expressions of the node which can be replaced. In addition to the child
+// expressions, calls of expression statements are returned, so rules can replace calls of functions without results.
+func expressions(node ast.Node) []*ast.Expr {
+	refs := astutil.ChildExpressions(node)
+	if n, ok := node.(*ast.ExprStmt); ok {
+		if _, ok := n.X.(*ast.CallExpr); ok {
+			refs = append(refs, &n.X)
+		}
+	}
+	return refs
+}
+
+// metavariable reports whether the expression is a metavariable of the rule.
+func (r Rule) metavariable(expr ast.Expr) (string, bool) {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return "", false
+	}
+	_, ok = r.Vars[ident.Name]
+	return ident.Name, ok
+}
+
+// metavariables returns the names of the metavariables of the rule used by the expression.
+func (r Rule) metavariables(expr ast.Expr) []string {
+	var names []string
+	for _, ident := range values(expr) {
+		if name, ok := r.metavariable(ident); ok && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// values returns the identifiers of the expression, except for names of selectors, which cannot be metavariables.
+func values(expr ast.Expr) []*ast.Ident {
+	selected := make(map[*ast.Ident]bool)
+	var idents []*ast.Ident
+	ast.Inspect(expr, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.SelectorExpr:
+			selected[n.Sel] = true
+		case *ast.Ident:
+			if !selected[n] {
+				idents = append(idents, n)
+			}
+		}
+		return true
+	})
+	return idents
+}
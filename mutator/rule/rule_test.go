@@ -0,0 +1,126 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func TestRuleEqualFold(t *testing.T) {
+	m, err := Compile(Rule{
+		Name:        "equal_fold",
+		Pattern:     "strings.EqualFold(a, b)",
+		Replacement: "a == b",
+		Vars:        map[string]string{"a": "string", "b": "string"},
+	})
+	require.NoError(t, err)
+
+	mutatortest.Run(
+		t,
+		m,
+		"../../testdata/rule/equal_fold.go",
+		3,
+	)
+}
+
+func TestRuleEqualFoldRenamed(t *testing.T) {
+	m, err := Compile(Rule{
+		Name:        "equal_fold",
+		Pattern:     "strings.EqualFold(a, b)",
+		Replacement: "a == b",
+		Vars:        map[string]string{"a": "string", "b": "string"},
+	})
+	require.NoError(t, err)
+
+	mutatortest.Run(
+		t,
+		m,
+		"../../testdata/rule/renamed.go",
+		2,
+	)
+}
+
+func TestRuleEqualFoldLastUse(t *testing.T) {
+	m, err := Compile(Rule{
+		Name:        "equal_fold",
+		Pattern:     "strings.EqualFold(a, b)",
+		Replacement: "a == b",
+		Vars:        map[string]string{"a": "string", "b": "string"},
+	})
+	require.NoError(t, err)
+
+	mutatortest.Run(
+		t,
+		m,
+		"../../testdata/rule/last_use.go",
+		0,
+	)
+}
+
+func TestRuleDuration(t *testing.T) {
+	m, err := Compile(Rule{
+		Name:        "sleep",
+		Pattern:     "time.Sleep(d + d)",
+		Replacement: "time.Sleep(d)",
+		Vars:        map[string]string{"d": "time.Duration"},
+	})
+	require.NoError(t, err)
+
+	mutatortest.Run(
+		t,
+		m,
+		"../../testdata/rule/duration.go",
+		1,
+	)
+}
+
+func TestCompile(t *testing.T) {
+	tt := []struct {
+		name        string
+		rule        Rule
+		expectedErr string
+	}{
+		{
+			name:        "invalid name",
+			rule:        Rule{Name: "a/b", Pattern: "a", Replacement: "b"},
+			expectedErr: `invalid rule name "a/b"`,
+		},
+		{
+			name:        "invalid pattern",
+			rule:        Rule{Name: "r", Pattern: "a +", Replacement: "a"},
+			expectedErr: `parse pattern of rule "r": 1:4: expected operand, found 'EOF'`,
+		},
+		{
+			name:        "invalid replacement",
+			rule:        Rule{Name: "r", Pattern: "a", Replacement: "a +"},
+			expectedErr: `parse replacement of rule "r": 1:4: expected operand, found 'EOF'`,
+		},
+		{
+			name:        "unused variable",
+			rule:        Rule{Name: "r", Pattern: "a.b", Replacement: "a", Vars: map[string]string{"b": "int"}},
+			expectedErr: `variable "b" of rule "r" is not used by the pattern`,
+		},
+		{
+			name:        "untyped variable",
+			rule:        Rule{Name: "r", Pattern: "a", Replacement: "a", Vars: map[string]string{"a": ""}},
+			expectedErr: `variable "a" of rule "r" has no type`,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Compile(tc.rule)
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestRegister(t *testing.T) {
+	r := Rule{Name: "register", Pattern: "a", Replacement: "-a", Vars: map[string]string{"a": "int"}}
+	require.NoError(t, Register(r))
+	assert.Contains(t, mutator.List(), "rule/register")
+	assert.EqualError(t, Register(r), `mutator "rule/register" already registered`)
+}
@@ -0,0 +1,49 @@
+package slice
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("slice/append", MutatorAppend)
+}
+
+// MutatorAppend implements a mutator to replace calls of append with the appended slice, e.g. "append(s, v)" is
+// replaced by "s", so the values are never appended.
+func MutatorAppend(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	var mutations []mutator.Mutation
+
+	for _, ref := range astutil.ChildExpressions(node) {
+		call, ok := ast.Unparen(*ref).(*ast.CallExpr)
+		if !ok || !astutil.IsBuiltin(info, call, "append") || len(call.Args) < 2 {
+			continue
+		}
+		if !isSliceable(info.TypeOf(call.Args[0])) {
+			continue
+		}
+		appended := make([]ast.Node, 0, len(call.Args)-1)
+		for _, arg := range call.Args[1:] {
+			appended = append(appended, arg)
+		}
+		if astutil.HasLastUse(info, appended...) {
+			continue
+		}
+
+		original := *ref
+		mutated := call.Args[0]
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				*ref = mutated
+			},
+			Reset: func() {
+				*ref = original
+			},
+		})
+	}
+
+	return mutations
+}
@@ -0,0 +1,16 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorAppend(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorAppend,
+		"../../testdata/slice/append.go",
+		3,
+	)
+}
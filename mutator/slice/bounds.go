@@ -0,0 +1,65 @@
+package slice
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("slice/bounds", MutatorBounds)
+}
+
+// MutatorBounds implements a mutator to shift bounds of slice expressions, i.e. the low bound is incremented and the
+// high bound is decremented, e.g. "s[a:b]" is replaced by "s[a+1:b]" and "s[a:b-1]". Shifts leading to invalid
+// constant bounds are skipped.
+func MutatorBounds(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	n, ok := node.(*ast.SliceExpr)
+	if !ok || !isSliceable(info.TypeOf(n.X)) {
+		return nil
+	}
+
+	low, lowOK := constantInt(info, n.Low)
+	if n.Low == nil {
+		low, lowOK = 0, true
+	}
+	high, highOK := constantInt(info, n.High)
+	if n.High == nil {
+		high, highOK = constantLength(info, n.X)
+	}
+	capacity, capacityOK := constantInt(info, n.Max)
+	if n.Max == nil {
+		capacity, capacityOK = constantLength(info, n.X)
+	}
+
+	var mutations []mutator.Mutation
+
+	if n.Low != nil && (!lowOK || !highOK || low+1 <= high) && (!lowOK || !capacityOK || low+1 <= capacity) {
+		original := n.Low
+		mutated := shift(original, 1)
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				n.Low = mutated
+			},
+			Reset: func() {
+				n.Low = original
+			},
+		})
+	}
+
+	if n.High != nil && (!highOK || high > 0) && (!lowOK || !highOK || low <= high-1) {
+		original := n.High
+		mutated := shift(original, -1)
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				n.High = mutated
+			},
+			Reset: func() {
+				n.High = original
+			},
+		})
+	}
+
+	return mutations
+}
@@ -0,0 +1,16 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorBounds(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorBounds,
+		"../../testdata/slice/bounds.go",
+		8,
+	)
+}
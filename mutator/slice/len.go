@@ -0,0 +1,50 @@
+package slice
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("slice/len", MutatorLen)
+}
+
+// MutatorLen implements a mutator to decrement lengths of slices, arrays and strings in comparisons, e.g. "i < len(s)"
+// is replaced by "i < len(s)-1". Lengths of maps and channels are not mutated.
+func MutatorLen(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	n, ok := node.(*ast.BinaryExpr)
+	if !ok {
+		return nil
+	}
+	switch n.Op {
+	case token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ:
+	default:
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	for _, ref := range []*ast.Expr{&n.X, &n.Y} {
+		call, ok := ast.Unparen(*ref).(*ast.CallExpr)
+		if !ok || !astutil.IsBuiltin(info, call, "len") || len(call.Args) != 1 || !isSliceable(info.TypeOf(call.Args[0])) {
+			continue
+		}
+
+		original := *ref
+		mutated := shift(original, -1)
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				*ref = mutated
+			},
+			Reset: func() {
+				*ref = original
+			},
+		})
+	}
+
+	return mutations
+}
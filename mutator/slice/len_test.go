@@ -0,0 +1,16 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorLen(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorLen,
+		"../../testdata/slice/len.go",
+		4,
+	)
+}
@@ -0,0 +1,50 @@
+package slice
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("slice/remove_bound", MutatorRemoveBound)
+}
+
+// MutatorRemoveBound implements a mutator to remove one of the bounds of slice expressions, e.g. "s[a:b]" is replaced
+// by "s[:b]" and "s[a:]". The high bound of full slice expressions is required and not removed.
+func MutatorRemoveBound(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	n, ok := node.(*ast.SliceExpr)
+	if !ok || !isSliceable(info.TypeOf(n.X)) {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	if n.Low != nil && !astutil.HasLastUse(info, n.Low) {
+		original := n.Low
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				n.Low = nil
+			},
+			Reset: func() {
+				n.Low = original
+			},
+		})
+	}
+
+	if n.High != nil && !n.Slice3 && !astutil.HasLastUse(info, n.High) {
+		original := n.High
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				n.High = nil
+			},
+			Reset: func() {
+				n.High = original
+			},
+		})
+	}
+
+	return mutations
+}
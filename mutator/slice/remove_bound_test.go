@@ -0,0 +1,16 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorRemoveBound(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorRemoveBound,
+		"../../testdata/slice/remove_bound.go",
+		4,
+	)
+}
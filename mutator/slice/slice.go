@@ -0,0 +1,77 @@
+package slice
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strconv"
+)
+
+// isSliceable reports whether the type is a slice, an array, a pointer to an array or a string.
+func isSliceable(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+	if p, ok := t.Underlying().(*types.Pointer); ok {
+		_, ok := p.Elem().Underlying().(*types.Array)
+		return ok
+	}
+	switch u := t.Underlying().(type) {
+	case *types.Slice, *types.Array:
+		return true
+	case *types.Basic:
+		return u.Info()&types.IsString != 0
+	}
+	return false
+}
+
+// constantLength returns the length of an array or a constant string.
+func constantLength(info *types.Info, x ast.Expr) (int64, bool) {
+	tv, ok := info.Types[x]
+	if !ok {
+		return 0, false
+	}
+	if tv.Value != nil && tv.Value.Kind() == constant.String {
+		return int64(len(constant.StringVal(tv.Value))), true
+	}
+	t := tv.Type.Underlying()
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem().Underlying()
+	}
+	if a, ok := t.(*types.Array); ok {
+		return a.Len(), true
+	}
+	return 0, false
+}
+
+// constantInt returns the value of a constant integer expression.
+func constantInt(info *types.Info, expr ast.Expr) (int64, bool) {
+	if expr == nil {
+		return 0, false
+	}
+	tv, ok := info.Types[expr]
+	if !ok || tv.Value == nil {
+		return 0, false
+	}
+	return constant.Int64Val(constant.ToInt(tv.Value))
+}
+
+// shift creates an expression adding the delta to the expression. Integer literals are changed in place.
+func shift(expr ast.Expr, delta int64) ast.Expr {
+	if lit, ok := expr.(*ast.BasicLit); ok && lit.Kind == token.INT {
+		if v, err := strconv.ParseInt(lit.Value, 0, 64); err == nil && v+delta >= 0 {
+			return &ast.BasicLit{Kind: token.INT, Value: strconv.FormatInt(v+delta, 10)}
+		}
+	}
+
+	op := token.ADD
+	if delta < 0 {
+		op, delta = token.SUB, -delta
+	}
+	return &ast.BinaryExpr{
+		X:  expr,
+		Op: op,
+		Y:  &ast.BasicLit{Kind: token.INT, Value: strconv.FormatInt(delta, 10)},
+	}
+}
@@ -0,0 +1,82 @@
+package statement
+
+import (
+	"go/ast"
+	"go/types"
+	"slices"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	mutator.Register("statement/early_return", MutatorEarlyReturn)
+}
+
+// MutatorEarlyReturn implements a mutator to insert return statements at the start of function bodies and after
+// statements of function bodies. Zero values of the results are returned, also by functions with named results, as a
+// naked return would return the values assigned so far. Return statements are not inserted at the end of a body and
+// before or after other return statements, as such mutants are equivalent to the original code or to mutants of the
+// return mutators. Mutations are reported at the statement the return statement is inserted before.
+func MutatorEarlyReturn(pkg *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	sig, body, ok := astutil.FuncSignature(info, node)
+	if !ok || len(body.List) == 0 {
+		return nil
+	}
+
+	ret, ok := createEarlyReturn(pkg, body, sig)
+	if !ok {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	original := body.List
+	for i, stmt := range original {
+		if _, ok := stmt.(*ast.ReturnStmt); ok {
+			continue
+		}
+		if i > 0 && isTerminating(info, original[i-1]) {
+			continue
+		}
+
+		mutations = append(mutations, mutator.Mutation{
+			Change: func() {
+				body.List = slices.Insert(slices.Clone(original), i, ast.Stmt(ret))
+			},
+			Reset: func() {
+				body.List = original
+			},
+			Node: stmt,
+		})
+	}
+
+	return mutations
+}
+
+// createEarlyReturn creates a return statement of the function with the signature. The second return argument is false
+// if a zero value of a result cannot be expressed in the function body.
+func createEarlyReturn(pkg *types.Package, body *ast.BlockStmt, sig *types.Signature) (*ast.ReturnStmt, bool) {
+	ret := &ast.ReturnStmt{}
+	for v := range sig.Results().Variables() {
+		zero, ok := astutil.CreateZeroValue(pkg, body.Lbrace, v.Type())
+		if !ok {
+			return nil, false
+		}
+		ret.Results = append(ret.Results, zero)
+	}
+
+	return ret, true
+}
+
+// isTerminating reports whether statements following the statement are unreachable.
+func isTerminating(info *types.Info, stmt ast.Stmt) bool {
+	switch s := stmt.(type) {
+	case *ast.ReturnStmt, *ast.BranchStmt:
+		return true
+	case *ast.ExprStmt:
+		call, ok := s.X.(*ast.CallExpr)
+		return ok && astutil.IsBuiltin(info, call, "panic")
+	}
+	return false
+}
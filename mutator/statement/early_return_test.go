@@ -0,0 +1,33 @@
+package statement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leonidboykov/go-mutesting"
+	"github.com/leonidboykov/go-mutesting/internal/importing"
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorEarlyReturn(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorEarlyReturn,
+		"../../testdata/statement/early_return.go",
+		9,
+	)
+}
+
+func TestMutatorEarlyReturnPosition(t *testing.T) {
+	src, pkg, err := importing.ParseAndTypeCheckFile(t.Context(), "../../testdata/statement/early_return.go")
+	require.NoError(t, err)
+
+	// Mutations are reported at the statements the return statements are inserted before.
+	var lines []int
+	for node := range mutesting.Mutations(pkg, src, MutatorEarlyReturn, nil) {
+		lines = append(lines, pkg.Fset.Position(node.Pos()).Line)
+	}
+	assert.Equal(t, []int{13, 20, 21, 26, 27, 32, 37, 40, 48}, lines)
+}
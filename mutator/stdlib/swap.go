@@ -0,0 +1,210 @@
+package stdlib
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/leonidboykov/go-mutesting/internal/astutil"
+	"github.com/leonidboykov/go-mutesting/mutator"
+)
+
+func init() {
+	for _, pair := range swapPairs {
+		if err := AddSwap(pair); err != nil {
+			panic(err)
+		}
+	}
+
+	mutator.Register("stdlib/swap", MutatorSwap)
+}
+
+// swapPairs are the default swaps of the mutator. Each pair is swapped in both directions.
+var swapPairs = []string{
+	"bytes.HasPrefix=HasSuffix",
+	"bytes.Index=LastIndex",
+	"bytes.IndexAny=LastIndexAny",
+	"bytes.IndexByte=LastIndexByte",
+	"bytes.IndexFunc=LastIndexFunc",
+	"bytes.ToLower=ToUpper",
+	"bytes.TrimLeft=TrimRight",
+	"bytes.TrimPrefix=TrimSuffix",
+	"math.Ceil=Floor",
+	"math.Max=Min",
+	"strings.HasPrefix=HasSuffix",
+	"strings.Index=LastIndex",
+	"strings.IndexAny=LastIndexAny",
+	"strings.IndexByte=LastIndexByte",
+	"strings.IndexFunc=LastIndexFunc",
+	"strings.ToLower=ToUpper",
+	"strings.TrimLeft=TrimRight",
+	"strings.TrimPrefix=TrimSuffix",
+	"(time.Time).After=Before",
+}
+
+// comparators maps functions to the index of their comparator argument. Comparators are inverted by swapping their
+// parameters.
+var comparators = map[string]int{
+	"slices.IsSortedFunc":   1,
+	"slices.MaxFunc":        1,
+	"slices.MinFunc":        1,
+	"slices.SortFunc":       1,
+	"slices.SortStableFunc": 1,
+	"sort.Slice":            1,
+	"sort.SliceStable":      1,
+	"sort.SliceIsSorted":    1,
+}
+
+var (
+	swapsMu sync.RWMutex
+	swaps   = make(map[string][]string)
+)
+
+// AddSwap adds a swap of the stdlib/swap mutator. The rule has the form "FUNC=NAME", where FUNC is the fully
+// qualified name of a function, e.g. "strings.ToUpper" or "(*bytes.Buffer).WriteString", and NAME is the name of
+// another function of the same package or another method of the same type. Rules are added in both directions,
+// replacements without an identical signature are ignored by the mutator.
+func AddSwap(rule string) error {
+	from, name, ok := strings.Cut(rule, "=")
+	from, name = strings.TrimSpace(from), strings.TrimSpace(name)
+	if !ok || !token.IsIdentifier(name) {
+		return fmt.Errorf("invalid swap %q: expected FUNC=NAME", rule)
+	}
+	i := strings.LastIndex(from, ".")
+	if i <= 0 || !token.IsIdentifier(from[i+1:]) {
+		return fmt.Errorf("invalid swap %q: expected fully qualified function name", rule)
+	}
+
+	swapsMu.Lock()
+	defer swapsMu.Unlock()
+	add := func(from, name string) {
+		if !slices.Contains(swaps[from], name) {
+			swaps[from] = append(swaps[from], name)
+		}
+	}
+	add(from, name)
+	add(from[:i+1]+name, from[i+1:])
+
+	return nil
+}
+
+// MutatorSwap implements a mutator to swap calls of standard library functions with related functions, e.g.
+// "strings.HasPrefix" with "strings.HasSuffix". Comparators of sorting functions are inverted by swapping their
+// parameters, e.g. "func(i, j int) bool" is replaced by "func(j, i int) bool".
+func MutatorSwap(_ *types.Package, info *types.Info, node ast.Node) []mutator.Mutation {
+	n, ok := node.(*ast.CallExpr)
+	if !ok {
+		return nil
+	}
+	fn, ok := astutil.CalledFunc(info, n)
+	if !ok {
+		return nil
+	}
+
+	var mutations []mutator.Mutation
+
+	if ident := calledIdent(n); ident != nil {
+		swapsMu.RLock()
+		names := swaps[fn.FullName()]
+		swapsMu.RUnlock()
+
+		for _, name := range names {
+			if !replaceable(fn, name) {
+				continue
+			}
+			original := ident.Name
+			mutations = append(mutations, mutator.Mutation{
+				Change: func() {
+					ident.Name = name
+				},
+				Reset: func() {
+					ident.Name = original
+				},
+			})
+		}
+	}
+
+	if i, ok := comparators[fn.FullName()]; ok {
+		if i >= len(n.Args) {
+			return mutations
+		}
+		if m, ok := comparatorInversion(info, n.Args[i]); ok {
+			mutations = append(mutations, m)
+		}
+	}
+
+	return mutations
+}
+
+// calledIdent returns the identifier naming the called function of the call.
+func calledIdent(call *ast.CallExpr) *ast.Ident {
+	fun := ast.Unparen(call.Fun)
+	switch f := fun.(type) {
+	case *ast.IndexExpr:
+		fun = f.X
+	case *ast.IndexListExpr:
+		fun = f.X
+	}
+
+	switch f := fun.(type) {
+	case *ast.Ident:
+		return f
+	case *ast.SelectorExpr:
+		return f.Sel
+	}
+	return nil
+}
+
+// replaceable reports whether the function can be replaced by the function or method with the given name, i.e. the
+// replacement exists and has an identical signature.
+func replaceable(fn *types.Func, name string) bool {
+	sig := fn.Signature()
+
+	var obj types.Object
+	if recv := sig.Recv(); recv != nil {
+		obj, _, _ = types.LookupFieldOrMethod(recv.Type(), false, fn.Pkg(), name)
+	} else if fn.Pkg() != nil {
+		obj = fn.Pkg().Scope().Lookup(name)
+	}
+
+	replacement, ok := obj.(*types.Func)
+	if !ok || !replacement.Exported() && replacement.Pkg() != fn.Pkg() {
+		return false
+	}
+	// Receivers are ignored by the comparison of signatures.
+	return types.Identical(sig, replacement.Signature())
+}
+
+// comparatorInversion returns a mutation swapping the parameters of the comparator function literal. Both parameters
+// need to have the same type.
+func comparatorInversion(info *types.Info, expr ast.Expr) (mutator.Mutation, bool) {
+	lit, ok := ast.Unparen(expr).(*ast.FuncLit)
+	if !ok {
+		return mutator.Mutation{}, false
+	}
+
+	var names []*ast.Ident
+	for _, field := range lit.Type.Params.List {
+		names = append(names, field.Names...)
+	}
+	if len(names) != 2 || names[0].Name == "_" || names[1].Name == "_" {
+		return mutator.Mutation{}, false
+	}
+	a, b := info.Defs[names[0]], info.Defs[names[1]]
+	if a == nil || b == nil || !types.Identical(a.Type(), b.Type()) {
+		return mutator.Mutation{}, false
+	}
+
+	return mutator.Mutation{
+		Change: func() {
+			names[0].Name, names[1].Name = names[1].Name, names[0].Name
+		},
+		Reset: func() {
+			names[0].Name, names[1].Name = names[1].Name, names[0].Name
+		},
+	}, true
+}
@@ -0,0 +1,42 @@
+package stdlib
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leonidboykov/go-mutesting/internal/mutatortest"
+)
+
+func TestMutatorSwap(t *testing.T) {
+	mutatortest.Run(
+		t,
+		MutatorSwap,
+		"../../testdata/stdlib/swap.go",
+		7,
+	)
+}
+
+func TestAddSwap(t *testing.T) {
+	swapsMu.Lock()
+	saved := make(map[string][]string, len(swaps))
+	for from, names := range swaps {
+		saved[from] = slices.Clone(names)
+	}
+	swapsMu.Unlock()
+	t.Cleanup(func() {
+		swapsMu.Lock()
+		swaps = saved
+		swapsMu.Unlock()
+	})
+
+	require.NoError(t, AddSwap("(*bytes.Buffer).WriteString=Write"))
+	assert.Contains(t, swaps["(*bytes.Buffer).WriteString"], "Write")
+	assert.Contains(t, swaps["(*bytes.Buffer).Write"], "WriteString")
+
+	assert.EqualError(t, AddSwap("strings.ToUpper"), `invalid swap "strings.ToUpper": expected FUNC=NAME`)
+	assert.EqualError(t, AddSwap("strings.ToUpper=strings.ToLower"), `invalid swap "strings.ToUpper=strings.ToLower": expected FUNC=NAME`)
+	assert.EqualError(t, AddSwap("ToUpper=ToLower"), `invalid swap "ToUpper=ToLower": expected fully qualified function name`)
+}
@@ -0,0 +1,34 @@
+package arithmetic
+
+import "fmt"
+
+type counter struct {
+	refs int
+}
+
+func incdec(c *counter, items []string) {
+	n := 0
+	for i := 0; i < len(items); i++ {
+		n++
+	}
+
+	seen := 0
+	for i := 0; i < len(items); seen++ {
+		i += 2
+	}
+
+	c.refs--
+
+	select {
+	case <-make(chan int):
+		n++
+	default:
+	}
+
+	switch n {
+	case 1:
+		c.refs++
+	}
+
+	fmt.Println(n, seen)
+}
@@ -0,0 +1,34 @@
+package arithmetic
+
+import "fmt"
+
+type refCounter struct {
+	refs int
+}
+
+func incdecNoop(c *refCounter, items []string) {
+	n := 0
+	for i := 0; i < len(items); i++ {
+		n++
+	}
+
+	seen := 0
+	for i := 0; i < len(items); seen++ {
+		i += 2
+	}
+
+	c.refs--
+
+	select {
+	case <-make(chan int):
+		n--
+	default:
+	}
+
+	switch n {
+	case 1:
+		_ = c.refs
+	}
+
+	fmt.Println(n, seen)
+}
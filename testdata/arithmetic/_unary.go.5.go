@@ -0,0 +1,34 @@
+package arithmetic
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type point struct {
+	X int
+}
+
+func unary(data []byte, p *point, flags uint8) {
+	var min int8 = -128
+	var max uint8 = +255
+	var low int = -2147483648
+	delta := -int(flags)
+	mask := ^flags
+
+	var v point
+	_ = json.Unmarshal(data, &v)
+	_ = json.Unmarshal(data, &p)
+
+	scale := func(p *point) {
+		p.X *= 2
+	}
+	scale(&v)
+
+	switch delta {
+	case -1:
+		delta = -1
+	}
+
+	fmt.Println(min, max, low, delta, mask, *p)
+}
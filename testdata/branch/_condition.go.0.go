@@ -0,0 +1,36 @@
+package branch
+
+import (
+	"fmt"
+	"strings"
+)
+
+func condition(s string, items []int) {
+	if true {
+		fmt.Println("prefix")
+	} else if len(s) > 3 {
+		fmt.Println("long")
+	}
+
+	if true {
+		fmt.Println("always")
+	}
+
+	if n, ok := lookup(s); ok {
+		fmt.Println(n)
+	}
+
+	for i := 0; i < len(items); i++ {
+		fmt.Println(items[i])
+	}
+
+	for {
+		break
+	}
+
+	fmt.Println(strings.ToUpper(s))
+}
+
+func lookup(s string) (int, bool) {
+	return len(s), s != ""
+}
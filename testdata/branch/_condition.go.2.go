@@ -0,0 +1,36 @@
+package branch
+
+import (
+	"fmt"
+	"strings"
+)
+
+func condition(s string, items []int) {
+	if strings.HasPrefix(s, "x") {
+		fmt.Println("prefix")
+	} else if true {
+		fmt.Println("long")
+	}
+
+	if true {
+		fmt.Println("always")
+	}
+
+	if n, ok := lookup(s); ok {
+		fmt.Println(n)
+	}
+
+	for i := 0; i < len(items); i++ {
+		fmt.Println(items[i])
+	}
+
+	for {
+		break
+	}
+
+	fmt.Println(strings.ToUpper(s))
+}
+
+func lookup(s string) (int, bool) {
+	return len(s), s != ""
+}
@@ -0,0 +1,36 @@
+package branch
+
+import (
+	"fmt"
+	"strings"
+)
+
+func conditionLoop(s string, items []int) {
+	if strings.HasPrefix(s, "x") {
+		fmt.Println("prefix")
+	} else if len(s) > 3 {
+		fmt.Println("long")
+	}
+
+	if true {
+		fmt.Println("always")
+	}
+
+	if n, ok := lookupLoop(s); ok {
+		fmt.Println(n)
+	}
+
+	for i := 0; true; i++ {
+		fmt.Println(items[i])
+	}
+
+	for {
+		break
+	}
+
+	fmt.Println(strings.ToUpper(s))
+}
+
+func lookupLoop(s string) (int, bool) {
+	return len(s), s != ""
+}
@@ -0,0 +1,48 @@
+package branch
+
+import (
+	"fmt"
+)
+
+func switchDefault(i int, v any, ch chan int) {
+	switch {
+	case i > 0:
+		fmt.Println("positive")
+
+	}
+
+	switch v.(type) {
+	case int:
+		fmt.Println("int")
+	default:
+	}
+
+	select {
+	case n := <-ch:
+		fmt.Println(n)
+	default:
+	}
+
+	select {
+	default:
+	}
+}
+
+func switchDefaultTerminating(x any, n int) int {
+	switch v := x.(type) {
+	case int:
+		return v + n
+	default:
+		return -1
+	}
+}
+
+func switchDefaultBreak(n int) int {
+	switch {
+	case n > 0:
+		break
+	default:
+		return -1
+	}
+	return n
+}
@@ -0,0 +1,34 @@
+package branch
+
+import (
+	"fmt"
+)
+
+func switchFallthrough(i int) int {
+	switch i {
+	case 1:
+		fmt.Println("one")
+		fallthrough
+	case 2:
+		fmt.Println("two")
+		fallthrough
+	case 3:
+		return 3
+	case 4:
+	default:
+		fmt.Println("many")
+	}
+
+	return 0
+}
+
+func switchFallthroughTerminating(i int) int {
+	switch i {
+	case 1:
+		fallthrough
+	case 2:
+		return 2
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,44 @@
+package branch
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+)
+
+type temporary interface {
+	Temporary() bool
+}
+
+func switchReorder(i int, limit int, err error) {
+	switch {
+	case i < 10:
+		fmt.Println("small")
+	case i < 100:
+		fmt.Println("medium")
+	default:
+		fmt.Println("large")
+	}
+
+	switch i {
+	case 1:
+		fmt.Println("one")
+	case 2:
+		fmt.Println("two")
+	case limit:
+		fmt.Println("limit")
+	}
+
+	switch err.(type) {
+	case *fs.PathError:
+		fmt.Println("path")
+
+	case interface{ Timeout() bool }:
+		fmt.Println("timeout")
+	case temporary:
+		fmt.Println("temporary")
+
+	}
+
+	fmt.Println(errors.Unwrap(err))
+}
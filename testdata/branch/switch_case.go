@@ -0,0 +1,29 @@
+package branch
+
+import (
+	"fmt"
+	"strings"
+)
+
+func switchCase(i int, v any) {
+	switch i {
+	case 1:
+		fmt.Println("one")
+		fallthrough
+	case 2:
+		fmt.Println("two")
+	case 3:
+		fmt.Println(strings.Repeat("3", i))
+	default:
+		fmt.Println("many")
+	}
+
+	switch x := v.(type) {
+	case int:
+		fmt.Println(x + 1)
+	case string:
+		fmt.Println(x)
+	case nil:
+		fmt.Println("nil")
+	}
+}
@@ -0,0 +1,9 @@
+package builtin
+
+func clearValues(m map[string]int, s []int) {
+	_ = m
+
+	if len(s) > 0 {
+		clear(s)
+	}
+}
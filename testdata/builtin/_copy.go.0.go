@@ -0,0 +1,8 @@
+package builtin
+
+func copyValues(dst []int, src []int) int {
+	_, _ = dst, src
+
+	n := copy(dst[1:], src)
+	return n
+}
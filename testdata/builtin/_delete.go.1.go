@@ -0,0 +1,9 @@
+package builtin
+
+func deleteKeys(m map[string]int, keys []string) {
+	for _, k := range keys {
+		_, _ = m, k
+
+	}
+	defer delete(m, "deferred")
+}
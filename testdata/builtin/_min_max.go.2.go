@@ -0,0 +1,14 @@
+package builtin
+
+func minMax(a int, b int, values []float64) (int, float64) {
+	lower := min(a, b)
+	upper := max(a, b, 10)
+	const limit = min(1, 2)
+	single := max(a)
+	return lower + upper + limit + single, max(values[0], values[1])
+}
+
+func shadowed(a int, b int) int {
+	max := 3
+	return min(a, b, max)
+}
@@ -0,0 +1,8 @@
+package builtin
+
+func clearValues(m map[string]int, s []int) {
+	clear(m)
+	if len(s) > 0 {
+		clear(s)
+	}
+}
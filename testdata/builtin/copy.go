@@ -0,0 +1,7 @@
+package builtin
+
+func copyValues(dst []int, src []int) int {
+	copy(dst, src)
+	n := copy(dst[1:], src)
+	return n
+}
@@ -0,0 +1,8 @@
+package builtin
+
+func lenCap(s []int, m map[string]int, str string, arr [3]int, ch chan int) int {
+	n := len(s) + cap(s)
+	n += len(m) + len(str) + len(arr)
+	n += len(ch)
+	return n
+}
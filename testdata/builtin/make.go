@@ -0,0 +1,9 @@
+package builtin
+
+func makeSlices(n int) ([]int, []string, map[string]int, []byte) {
+	values := make([]int, n)
+	names := make([]string, 3, 10)
+	m := make(map[string]int, n)
+	empty := make([]byte, 0, n)
+	return values, names, m, empty
+}
@@ -0,0 +1,24 @@
+package call
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+type builder struct {
+	parts []string
+}
+
+func (b *builder) add(s string) *builder {
+	b.parts = append(b.parts, s)
+	return b
+}
+
+func normalize(name string, dirs []string) (string, []string, int) {
+	name = strings.ToLower(name)
+	b := (&builder{}).add(name).add("x")
+	p := filepath.Join(dirs...)
+	s := make([]string, 0, len(dirs))
+	return filepath.Clean(p), slices.Sorted(slices.Values(append(s, b.parts...))), len(dirs)
+}
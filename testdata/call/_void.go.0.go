@@ -0,0 +1,14 @@
+package call
+
+import (
+	"io"
+	"os"
+)
+
+func write(w io.Writer, f *os.File, data []byte) {
+	_, _ = w.Write, data
+	_ = f.Close()
+	_, _ = f.WriteString("x")
+	n := copy(data, "abc")
+	os.Exit(n)
+}
@@ -0,0 +1,26 @@
+package commaok
+
+import (
+	"errors"
+	"fmt"
+)
+
+func check(m map[string]int, err error) {
+	if _, ok := m["a"]; ok {
+		fmt.Println("a")
+	}
+
+	if v, ok := m["b"]; ok && v > 0 {
+		fmt.Println(v)
+	}
+
+	if target, ok := err.(interface{ Unwrap() error }); ok {
+		fmt.Println("plain")
+	} else {
+		fmt.Println(target.Unwrap())
+	}
+
+	if err := errors.New("x"); err != nil {
+		fmt.Println(err)
+	}
+}
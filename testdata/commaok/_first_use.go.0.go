@@ -0,0 +1,29 @@
+package commaok
+
+import (
+	"fmt"
+)
+
+func firstUse(m map[string]int, x any) int {
+	v, ok := m["a"]
+	if ok {
+		return 0
+	}
+
+	s, ok := x.(fmt.Stringer)
+	fmt.Println(s, ok, v)
+
+	n, found := m["b"]
+	found = n > 0
+	fmt.Println(found)
+
+	var done bool
+	_, done = m["c"]
+	for !done {
+		break
+	}
+
+	_, _ = x.(error)
+
+	return v
+}
@@ -0,0 +1,23 @@
+package concurrency
+
+func closeChannel(values []int) <-chan int {
+	ch := make(chan int, len(values))
+	for _, v := range values {
+		ch <- v
+	}
+	close(ch)
+	return ch
+}
+
+func closeDeferred(ch chan int) {
+	_ = ch
+
+	ch <- 1
+}
+
+func closeNotBuiltin(ch chan int) {
+	close := func(chan int) {
+		ch <- 0
+	}
+	close(ch)
+}
@@ -0,0 +1,36 @@
+package concurrency
+
+import (
+	"fmt"
+	"os"
+)
+
+func deferClose(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	_ = f.Close
+
+	defer func() {
+		_ = recover()
+	}()
+
+	return nil
+}
+
+func deferLastUse(name string) {
+	f, _ := os.Open(name)
+	defer f.Close()
+}
+
+func deferRecover(name string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	_, err = os.Open(name)
+	return err
+}
@@ -0,0 +1,12 @@
+package concurrency
+
+func goStatements(ch chan int) {
+	func() {
+		ch <- 1
+	}()
+	go send(ch, 2)
+}
+
+func send(ch chan int, v int) {
+	ch <- v
+}
@@ -0,0 +1,12 @@
+package concurrency
+
+func goStatements(ch chan int) {
+	go func() {
+		ch <- 1
+	}()
+	send(ch, 2)
+}
+
+func send(ch chan int, v int) {
+	ch <- v
+}
@@ -0,0 +1,36 @@
+package concurrency
+
+import "sync"
+
+type counter struct {
+	sync.Mutex
+}
+
+func (c *counter) inc(key string) {
+	_ = c.Lock
+	_ = c.Unlock
+	counts[key]++
+}
+
+var counts = map[string]int{}
+
+type cache struct {
+	mu sync.RWMutex
+}
+
+var other sync.RWMutex
+
+var items = map[string]string{}
+
+func (c *cache) get(key string) string {
+	c.mu.RLock()
+	v := items[key]
+	c.mu.RUnlock()
+	return v
+}
+
+func (c *cache) set(key, value string) {
+	c.mu.Lock()
+	items[key] = value
+	other.Unlock()
+}
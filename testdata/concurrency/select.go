@@ -0,0 +1,21 @@
+package concurrency
+
+import "time"
+
+func selectCases(ch chan int, done chan struct{}) int {
+	select {
+	case v := <-ch:
+		return v
+	case <-done:
+		return 0
+	case <-time.After(time.Second):
+		return -1
+	}
+}
+
+func selectSingle(ch chan int) int {
+	select {
+	case v := <-ch:
+		return v
+	}
+}
@@ -0,0 +1,26 @@
+package concurrency
+
+import "sync"
+
+func waitGroup(n int) {
+	var wg sync.WaitGroup
+	for range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+		}()
+	}
+	wg.Wait()
+}
+
+type group struct {
+	sync.Mutex
+}
+
+func (g *group) Add(int) {
+	g.Lock()
+}
+
+func waitNotSync(g *group) {
+	g.Add(1)
+}
@@ -0,0 +1,19 @@
+package conditional
+
+import (
+	"bytes"
+	"strings"
+)
+
+type flag bool
+
+func isSet(f flag) flag {
+	return f
+}
+
+func booleanCall(s string, a, b []byte) (bool, flag) {
+	if strings.HasPrefix(s, "x") || bytes.Equal(a, b) {
+		return bool(true), isSet(false)
+	}
+	return strings.Contains(s, "y"), false
+}
@@ -0,0 +1,10 @@
+package conditional
+
+const debug = true
+
+func booleanLiteral(a bool) bool {
+	if debug || a == true {
+		return false
+	}
+	return !debug
+}
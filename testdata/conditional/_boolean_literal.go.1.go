@@ -0,0 +1,10 @@
+package conditional
+
+const debug = false
+
+func booleanLiteral(a bool) bool {
+	if debug || a == false {
+		return false
+	}
+	return !debug
+}
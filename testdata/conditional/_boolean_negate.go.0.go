@@ -0,0 +1,21 @@
+package conditional
+
+import "strings"
+
+type set map[string]bool
+
+func (s set) has(key string) bool {
+	return s[key]
+}
+
+func booleanNegate(s set, keys []string) bool {
+	if len(keys) > 0 && (!s.has(keys[0]) || !s.has("")) {
+		return true
+	}
+	for i := 0; s.has(keys[i]); i++ {
+		if strings.HasPrefix(keys[i], "x") {
+			return false
+		}
+	}
+	return false
+}
@@ -0,0 +1,8 @@
+package conditional
+
+func logical(a, b, c bool) bool {
+	if a || b || c {
+		return true
+	}
+	return a || (b && !c)
+}
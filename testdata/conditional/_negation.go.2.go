@@ -0,0 +1,14 @@
+package conditional
+
+func negation(a, b bool, n int) bool {
+	if !a {
+		return false
+	}
+	for i := 0; i < n && (a && b); i++ {
+		a = !a
+	}
+	if b {
+		return a
+	}
+	return !b
+}
@@ -0,0 +1,10 @@
+package conditional
+
+const debug = false
+
+func booleanLiteral(a bool) bool {
+	if debug || a == true {
+		return false
+	}
+	return !debug
+}
@@ -0,0 +1,28 @@
+package context
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+func withTimeout(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	_ = cancel
+
+	return wait(ctx)
+}
+
+func withCause(ctx context.Context) error {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(errors.New("done"))
+	return wait(ctx)
+}
+
+func wait(ctx context.Context) error {
+	defer func() {
+		_ = recover()
+	}()
+	<-ctx.Done()
+	return ctx.Err()
+}
@@ -0,0 +1,25 @@
+package context
+
+import (
+	"context"
+)
+
+func consume(ctx context.Context, in <-chan int) (int, error) {
+	sum := 0
+	for {
+		select {
+
+		case v, ok := <-in:
+			if !ok {
+				return sum, nil
+			}
+			sum += v
+		}
+	}
+}
+
+func block(ctx context.Context) {
+	select {
+	case <-ctx.Done():
+	}
+}
@@ -0,0 +1,25 @@
+package context
+
+import (
+	"context"
+)
+
+func process(ctx context.Context, items []string) ([]string, error) {
+	var out []string
+	for _, item := range items {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if ctx.Err() != nil {
+			break
+		} else {
+			out = append(out, item)
+		}
+	}
+
+	return out, nil
+}
+
+func errorsIs(err error) bool {
+	return err == context.Canceled
+}
@@ -0,0 +1,25 @@
+package context
+
+import (
+	"context"
+)
+
+func process(ctx context.Context, items []string) ([]string, error) {
+	var out []string
+	for _, item := range items {
+
+		if ctx.Err() != nil {
+			break
+		} else {
+			out = append(out, item)
+		}
+	}
+	if errorsIs(ctx.Err()) {
+		return out, nil
+	}
+	return out, nil
+}
+
+func errorsIs(err error) bool {
+	return err == context.Canceled
+}
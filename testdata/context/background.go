@@ -0,0 +1,33 @@
+package context
+
+import (
+	"context"
+	"net/http"
+)
+
+func fetch(ctx context.Context, client *http.Client, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.Do(req)
+}
+
+func fetchAll(ctx context.Context, client *http.Client, urls []string) error {
+	for _, url := range urls {
+		if _, err := fetch(ctx, client, url); err != nil {
+			return err
+		}
+	}
+	_, err := fetch(context.TODO(), client, "")
+	return err
+}
+
+func detached(ctx context.Context) {
+	local := context.WithoutCancel(ctx)
+	run(local)
+}
+
+func run(ctx context.Context) {
+	_ = ctx
+}
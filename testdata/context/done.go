@@ -0,0 +1,26 @@
+package context
+
+import (
+	"context"
+)
+
+func consume(ctx context.Context, in <-chan int) (int, error) {
+	sum := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return sum, ctx.Err()
+		case v, ok := <-in:
+			if !ok {
+				return sum, nil
+			}
+			sum += v
+		}
+	}
+}
+
+func block(ctx context.Context) {
+	select {
+	case <-ctx.Done():
+	}
+}
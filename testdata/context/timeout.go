@@ -0,0 +1,21 @@
+package context
+
+import (
+	"context"
+	"time"
+)
+
+func deadline(parent context.Context, at time.Time) error {
+	ctx, cancel := context.WithDeadline(parent, at)
+	defer cancel()
+	return wait(ctx)
+}
+
+func timeout(parent context.Context) error {
+	ctx, cancel := context.WithTimeout(parent, time.Minute)
+	defer cancel()
+	d := time.Second
+	short, stop := context.WithTimeout(ctx, d)
+	defer stop()
+	return wait(short)
+}
@@ -0,0 +1,30 @@
+package errorhandling
+
+import (
+	"os"
+	"strconv"
+)
+
+func invertAssign(s string) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+func invertElse(name string) (string, error) {
+	if data, err := os.ReadFile(name); err == nil {
+		return "", err
+	} else if len(data) == 0 {
+		return "", nil
+	}
+	return "", nil
+}
+
+func invertNotError(p *int) int {
+	if p != nil {
+		return *p
+	}
+	return 0
+}
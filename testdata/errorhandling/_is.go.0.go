@@ -0,0 +1,20 @@
+package errorhandling
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+)
+
+func is(err error) bool {
+	if false {
+		return true
+	}
+	var pathErr *fs.PathError
+	return errors.As(err, &pathErr) && pathErr.Op == "open"
+}
+
+func isLastUse(err error) bool {
+	var target *os.LinkError
+	return errors.As(err, &target)
+}
@@ -0,0 +1,53 @@
+package errorhandling
+
+import (
+	"errors"
+	"os"
+	"strconv"
+)
+
+func guardAssign(s string) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+func guardInit(name string) error {
+	_, _ = os.Stat(name)
+
+	return nil
+}
+
+func guardElse(name string) (string, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return "", err
+	} else if len(data) == 0 {
+		return "", nil
+	}
+	return string(data), nil
+}
+
+func guardLastUse(s string) error {
+	_, err := strconv.Atoi(s)
+	if err != nil {
+		return errors.New("not a number")
+	}
+	return nil
+}
+
+func guardNoReturn(s string) {
+	_, err := strconv.Atoi(s)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func guardNotError(p *int) int {
+	if p != nil {
+		return *p
+	}
+	return 0
+}
@@ -0,0 +1,19 @@
+package errorhandling
+
+import (
+	"errors"
+	"fmt"
+)
+
+var errSentinel = errors.New("sentinel")
+
+func unwrap(name string, err error) error {
+	if name == "" {
+		return fmt.Errorf("%w: %w", errSentinel, err)
+	}
+	return fmt.Errorf("open %q (100%%): %+v", name, err)
+}
+
+func unwrapNoVerb(name string) error {
+	return fmt.Errorf("open %s: %v", name, errSentinel)
+}
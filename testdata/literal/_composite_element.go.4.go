@@ -0,0 +1,10 @@
+package literal
+
+func compositeElement(limit int) ([]string, map[string]int, [3]int, [2]int) {
+	names := []string{"a", "b"}
+	ports := map[string]int{"http": 80, "https": 443}
+	sizes := [3]int{1, 2, 3}
+	fixed := [...]int{1, 2}
+	single := []string{"only"}
+	return append(names, single...), ports, sizes, fixed
+}
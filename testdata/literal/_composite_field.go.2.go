@@ -0,0 +1,14 @@
+package literal
+
+type endpoint struct {
+	Host, Port, Path string
+}
+
+func compositeField(host string) []endpoint {
+	return []endpoint{
+		{Host: host, Port: "80", Path: ""},
+		{Port: "8080"},
+		{"example.com", "443", "/"},
+		{Port: "1"},
+	}
+}
@@ -0,0 +1,14 @@
+package literal
+
+type endpoint struct {
+	Host, Port, Path string
+}
+
+func compositeField(host string) []endpoint {
+	return []endpoint{
+		{Host: host, Port: "80", Path: ""},
+		{Host: "localhost"},
+		{"example.com", "443", "/"},
+		{Port: "1"},
+	}
+}
@@ -0,0 +1,12 @@
+package literal
+
+func classify(r rune, b byte) int {
+	switch r {
+	case 'a', '\x00':
+		return 1
+	}
+	if b == 'x' {
+		return 2
+	}
+	return int(r - 'a' + 'A')
+}
@@ -0,0 +1,33 @@
+package literal
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+)
+
+const greeting = "hello"
+
+const key = "abc"
+
+func last() byte {
+	return key[2]
+}
+
+func describe(kind string, n int) (string, error) {
+	switch kind {
+	case "", "empty":
+		return "", nil
+	case "mutant":
+		return "x", nil
+	}
+	names := map[string]int{"": 0, "mutant": 1}
+	if _, ok := names[kind]; !ok {
+		slog.Info("unknown kind", slog.String("kind", kind))
+		return "", fmt.Errorf("unknown kind %q", kind)
+	}
+	if len("abc") > n {
+		return "abc"[:n], errors.New("too short")
+	}
+	return greeting + ", " + kind, nil
+}
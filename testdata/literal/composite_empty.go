@@ -0,0 +1,13 @@
+package literal
+
+type options struct {
+	Name string
+}
+
+func compositeEmpty(name string) (*options, []int, map[string]bool, [3]int) {
+	defaults := &options{Name: "default"}
+	values := []int{1, 2, 3}
+	seen := map[string]bool{name: true}
+	fixed := [...]int{1, 2, 3}
+	return defaults, values, seen, fixed
+}
@@ -0,0 +1,13 @@
+package literal
+
+import (
+	"fmt"
+	"log"
+	"log/slog"
+)
+
+func logging(name string) error {
+	log.Printf("open %s", name)
+	slog.Info("open", slog.String("name", name))
+	return fmt.Errorf("open %s", fmt.Sprint("file"))
+}
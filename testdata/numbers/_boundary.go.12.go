@@ -0,0 +1,22 @@
+package numbers
+
+import "time"
+
+const limit = 10
+
+func boundary(s []int, m map[int]int, u uint8) (int, float64) {
+	hex := 0xFF
+	f := 2.5e3
+	n := -42
+	u += 0b101
+	v := s[1] + m[-3]
+	v /= 4
+	v = v % (4)
+	v <<= 1_0
+	_ = [3]int{}[1]
+	_ = time.Second * 5
+	switch v {
+	case 1, 2:
+	}
+	return hex + n + v + int(u) + limit, f
+}
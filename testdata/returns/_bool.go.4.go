@@ -0,0 +1,18 @@
+package returns
+
+func boolLiterals(n int) bool {
+	if n > 0 {
+		return true
+	}
+	return false
+}
+
+func boolExpressions(a, b int) (bool, bool) {
+	return a < b && b > 0, !boolLiterals(a)
+}
+
+type flag bool
+
+func boolNamed(n int) flag {
+	return !flag(n > 0)
+}
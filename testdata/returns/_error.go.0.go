@@ -0,0 +1,18 @@
+package returns
+
+import (
+	"errors"
+	"strconv"
+)
+
+func errorResult(s string) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, errors.Join(err)
+	}
+	return n, errors.New("mutant")
+}
+
+func errorOnly() error {
+	return nil
+}
@@ -0,0 +1,26 @@
+package returns
+
+import (
+	"errors"
+	"os"
+)
+
+var errNotFound = errors.New("not found")
+
+func nilError(name string) (*os.File, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	if f == nil {
+		return nil, nil
+	}
+	return f, nil
+}
+
+func nilErrorOnly(ok bool) error {
+	if !ok {
+		return errors.New("not ok")
+	}
+	return nil
+}
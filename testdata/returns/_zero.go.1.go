@@ -0,0 +1,54 @@
+package returns
+
+import (
+	"net/http"
+	"strings"
+)
+
+type point struct {
+	x, y int
+}
+
+func zeroInt(a, b int) int {
+	if a > b {
+		return a - b
+	}
+	return 0
+}
+
+func zeroString(s string) (string, bool) {
+	if s == "" {
+		return "", false
+	}
+	return strings.TrimSpace(s), false
+}
+
+func zeroStruct() (point, *point, []int, http.Header) {
+	p := point{1, 2}
+	return p, &p, []int{p.x}, http.Header{"X": nil}
+}
+
+func zeroClosure() func() float64 {
+	return func() float64 {
+		return 1.5
+	}
+}
+
+func zeroGeneric[T any](v T) T {
+	return v
+}
+
+func zeroMulti() (int, int) {
+	return zeroMultiCall()
+}
+
+func zeroMultiCall() (int, int) {
+	var a, b int
+	return a, b
+}
+
+func zeroAssigned() int {
+	x := 1
+	x = 2
+	return x
+}
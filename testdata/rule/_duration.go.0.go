@@ -0,0 +1,13 @@
+package rule
+
+import (
+	"time"
+)
+
+func backoff(attempt int, base time.Duration) time.Duration {
+	timeout := base * time.Duration(attempt)
+	time.Sleep(timeout)
+	time.Sleep(base)
+	time.Sleep(base + time.Second)
+	return timeout
+}
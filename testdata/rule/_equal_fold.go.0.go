@@ -0,0 +1,21 @@
+package rule
+
+import (
+	"strings"
+)
+
+type header struct {
+	name string
+}
+
+func matches(h header, name string, names []string) bool {
+	if h.name == name {
+		return true
+	}
+	for _, n := range names {
+		if strings.EqualFold(n, "*") {
+			return true
+		}
+	}
+	return strings.EqualFold(string(name[0]), name[:1])
+}
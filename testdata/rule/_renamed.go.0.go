@@ -0,0 +1,16 @@
+package rule
+
+import (
+	str "strings"
+)
+
+type folder struct{}
+
+func (folder) EqualFold(a, b string) bool {
+	return a == b
+}
+
+func renamed(a, b string) bool {
+	strings := folder{}
+	return a == b || str.EqualFold(b, a) || strings.EqualFold(a, b)
+}
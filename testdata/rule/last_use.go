@@ -0,0 +1,9 @@
+package rule
+
+import (
+	"strings"
+)
+
+func lastUse(a, b string) bool {
+	return strings.EqualFold(a, b)
+}
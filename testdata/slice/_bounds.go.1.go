@@ -0,0 +1,16 @@
+package slice
+
+func window(s []int, a int, b int) []int {
+	return s[a : b-1]
+}
+
+func bounds(s []int, arr *[4]int, name string) [][]int {
+	return [][]int{
+		s[1:],
+		arr[2:4],
+		[]int{len(name[:3])},
+		s[1:3:4],
+		[]int{len("abc"[1:1])},
+		s[:],
+	}
+}
@@ -0,0 +1,19 @@
+package slice
+
+func length(s []int, m map[string]int) int {
+	var empty [0]int
+	total := 0
+	for i := 0; i < len(s); i++ {
+		total += s[i]
+	}
+	if len("abc")-1 == len(s) {
+		total++
+	}
+	if len(m) > 0 {
+		total--
+	}
+	if len(empty) == total {
+		total++
+	}
+	return total + len(s)
+}
@@ -0,0 +1,9 @@
+package slice
+
+func removeBound(s []int, name string) ([]int, string) {
+	from := 1
+	s = s[1:3]
+	s = s[:from]
+	s = s[:2:4]
+	return s, name[2:]
+}
@@ -0,0 +1,9 @@
+package slice
+
+func appendValues(s []int, extra []int) []int {
+	s = append(s, 1, 2)
+	s = append(s, extra...)
+	last := 4
+	s = append(s, last)
+	return append(s, 5)
+}
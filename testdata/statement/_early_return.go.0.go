@@ -0,0 +1,52 @@
+package statement
+
+import (
+	"errors"
+	"fmt"
+)
+
+type result struct {
+	value int
+}
+
+func compute(a int, b int) (int, error) {
+	return 0, nil
+	if b == 0 {
+		return 0, errors.New("division by zero")
+	}
+	return a / b, nil
+}
+
+func named(s string) (n int, err error) {
+	n = len(s)
+	fmt.Println(n)
+	return n, nil
+}
+
+func pointer() *result {
+	r := &result{value: 1}
+	r.value++
+	return r
+}
+
+func generic[T any](v T) T {
+	fmt.Println(v)
+	return v
+}
+
+func noResults(items []string) {
+	for _, item := range items {
+		fmt.Println(item)
+	}
+	fmt.Println(len(items))
+}
+
+func empty() {
+}
+
+func closure() func() int {
+	return func() int {
+		fmt.Println("called")
+		return 1
+	}
+}
@@ -0,0 +1,37 @@
+package stdlib
+
+import (
+	"bytes"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+func trim(s string, b []byte) (string, []byte) {
+	if strings.HasPrefix(s, "#") {
+		s = s[strings.Index(s, " ")+1:]
+	}
+	return s, bytes.TrimLeft(b, " ")
+}
+
+func round(x float64) float64 {
+	return math.Ceil(x)
+}
+
+func expired(t, deadline time.Time) bool {
+	return t.Before(deadline)
+}
+
+func order(items []int, names []string) {
+	sort.Slice(items, func(i, j int) bool {
+		return items[i] < items[j]
+	})
+	slices.SortFunc(names, func(a, b string) int {
+		return strings.Compare(a, b)
+	})
+	sort.Slice(items, func(_, j int) bool {
+		return items[j] > 0
+	})
+}
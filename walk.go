@@ -3,6 +3,7 @@ package mutesting
 import (
 	"fmt"
 	"go/ast"
+	"iter"
 	"strings"
 
 	"golang.org/x/tools/go/packages"
@@ -29,18 +30,39 @@ func PrintWalk(node ast.Node) {
 // mutated by the mutator. If a node can be mutated the method Mutate of the given mutator is executed with the node and
 // the control channel. After completion of the traversal the control channel is closed.
 func MutateWalk(pkg *packages.Package, node ast.Node, m mutator.Mutator, skippedLines map[int]struct{}, changeFunc, resetFunc func()) {
-	for node := range ast.Preorder(node) {
-		line := pkg.Fset.Position(node.Pos()).Line
-		if _, ok := skippedLines[line]; ok {
-			continue
-		}
+	for _, m := range Mutations(pkg, node, m, skippedLines) {
+		m.Change()
+		changeFunc()
+
+		m.Reset()
+		resetFunc()
+	}
+}
 
-		for _, m := range m(pkg.Types, pkg.TypesInfo, node) {
-			m.Change()
-			changeFunc()
+// Mutations returns an iterator over all mutations of the given node and its children produced by the given mutator.
+// Every mutation is yielded together with the node it is reported at, which is the node it was produced for unless the
+// mutation sets another one. Nodes on skipped lines are ignored. Mutations are not applied, it is up to the caller to
+// change and reset them.
+func Mutations(pkg *packages.Package, node ast.Node, m mutator.Mutator, skippedLines map[int]struct{}) iter.Seq2[ast.Node, mutator.Mutation] {
+	return func(yield func(ast.Node, mutator.Mutation) bool) {
+		for node := range ast.Preorder(node) {
+			line := pkg.Fset.Position(node.Pos()).Line
+			if _, ok := skippedLines[line]; ok {
+				continue
+			}
 
-			m.Reset()
-			resetFunc()
+			for _, m := range m(pkg.Types, pkg.TypesInfo, node) {
+				at := node
+				if m.Node != nil {
+					at = m.Node
+					if _, ok := skippedLines[pkg.Fset.Position(at.Pos()).Line]; ok {
+						continue
+					}
+				}
+				if !yield(at, m) {
+					return
+				}
+			}
 		}
 	}
 }